@@ -0,0 +1,476 @@
+// Package analytics computes the time spent by the issues in each status and status category,
+// along with their lead and cycle times, using the issue changelogs.
+//
+// The issues can be analyzed from search results including the "changelog" expand, the truncated changelogs
+// (more than 100 histories) are fetched using the changelog service when it's provided:
+//
+//	analyzer := &analytics.Analyzer{
+//		Changelog: client.Issue.Changelog,
+//		Statuses:  client.Workflow.Status,
+//		Calendar:  analytics.NewWorkingHours(location, 9*time.Hour, 17*time.Hour),
+//	}
+//
+//	report, err := analyzer.Issues(ctx, issues)
+//	err = report.WriteCSV(os.Stdout)
+package analytics
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The keys of the Jira status categories.
+const (
+	CategoryToDo       = "new"
+	CategoryInProgress = "indeterminate"
+	CategoryDone       = "done"
+)
+
+// ChangelogFetcher fetches the full changelog of an issue, it's implemented by the Jira changelog service.
+type ChangelogFetcher interface {
+	All(ctx context.Context, issueKeyOrID string, maxResults int) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error)
+}
+
+// StatusFetcher fetches the statuses of the active workflows, it's implemented by the Jira workflow status service.
+type StatusFetcher interface {
+	Bulk(ctx context.Context) ([]*model.StatusDetailScheme, *model.ResponseScheme, error)
+}
+
+// Duration is a time.Duration encoded as a number of seconds on the JSON reports.
+type Duration time.Duration
+
+// Hours returns the duration as a floating point number of hours.
+func (d Duration) Hours() float64 { return time.Duration(d).Hours() }
+
+// MarshalJSON encodes the duration as a number of seconds.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(time.Duration(d).Seconds(), 'f', -1, 64)), nil
+}
+
+// StatusTimeScheme represents the time spent in a status.
+type StatusTimeScheme struct {
+	StatusID string   `json:"statusId,omitempty"` // The ID of the status.
+	Status   string   `json:"status,omitempty"`   // The name of the status.
+	Category string   `json:"category,omitempty"` // The key of the status category, empty when unknown.
+	Time     Duration `json:"time"`               // The time spent in the status.
+	Visits   int      `json:"visits"`             // The number of times the issue entered the status.
+}
+
+// IssueReportScheme represents the time spent by an issue in each status.
+type IssueReportScheme struct {
+	Key        string              `json:"key"`                  // The key of the issue.
+	Created    time.Time           `json:"created"`              // The creation time of the issue.
+	Started    *time.Time          `json:"started,omitempty"`    // The first time the issue entered an in progress status.
+	Done       *time.Time          `json:"done,omitempty"`       // The last time the issue entered a done status, nil when not done.
+	LeadTime   Duration            `json:"leadTime"`             // The time from the creation to the done status, zero when not done.
+	CycleTime  Duration            `json:"cycleTime"`            // The time from the first in progress status to the done status.
+	Statuses   []*StatusTimeScheme `json:"statuses"`             // The time spent in each status, in the order they were entered.
+	Categories map[string]Duration `json:"categories,omitempty"` // The time spent in each status category.
+}
+
+// StatisticsScheme represents the statistics of a duration across several issues.
+type StatisticsScheme struct {
+	Count  int      `json:"count"`  // The number of issues measured.
+	Total  Duration `json:"total"`  // The sum of the durations.
+	Mean   Duration `json:"mean"`   // The mean duration.
+	Median Duration `json:"median"` // The median duration.
+	P85    Duration `json:"p85"`    // The 85th percentile duration.
+}
+
+// StatusStatisticsScheme represents the statistics of the time spent in a status across several issues.
+type StatusStatisticsScheme struct {
+	StatusID string            `json:"statusId,omitempty"` // The ID of the status.
+	Status   string            `json:"status,omitempty"`   // The name of the status.
+	Category string            `json:"category,omitempty"` // The key of the status category.
+	Time     *StatisticsScheme `json:"time"`               // The time spent in the status by the issues that entered it.
+}
+
+// ReportScheme represents the per issue and aggregate time reports.
+type ReportScheme struct {
+	Issues     []*IssueReportScheme         `json:"issues"`     // The per issue reports.
+	Statuses   []*StatusStatisticsScheme    `json:"statuses"`   // The statistics per status, sorted by status name.
+	Categories map[string]*StatisticsScheme `json:"categories"` // The statistics per status category.
+	LeadTime   *StatisticsScheme            `json:"leadTime"`   // The lead time statistics of the done issues.
+	CycleTime  *StatisticsScheme            `json:"cycleTime"`  // The cycle time statistics of the done issues.
+}
+
+// Analyzer computes the time reports of the issues.
+type Analyzer struct {
+	// Changelog fetches the changelogs missing or truncated on the issues, optional.
+	Changelog ChangelogFetcher
+	// Calendar measures the time spent in the statuses, Continuous when nil.
+	Calendar Calendar
+	// StatusCategories maps the status IDs or names to the status category keys (new, indeterminate, done).
+	// The changelogs don't include the categories, when it's nil the categories are loaded once from the
+	// Statuses fetcher, and the current status category of each issue is used as a fallback.
+	StatusCategories map[string]string
+	// Statuses fetches the statuses of the workflows used to categorise the statuses when StatusCategories is nil,
+	// e.g. client.Workflow.Status. Without both, only the current status of each issue is categorised.
+	Statuses StatusFetcher
+	// Now returns the end of the open intervals, time.Now when nil.
+	Now func() time.Time
+
+	mu         sync.Mutex
+	categories map[string]string
+}
+
+// Issue computes the time report of an issue.
+func (a *Analyzer) Issue(ctx context.Context, issue *model.IssueScheme) (*IssueReportScheme, error) {
+
+	if issue == nil || issue.Fields == nil {
+		return nil, model.ErrNoIssueScheme
+	}
+
+	var histories []*model.IssueChangelogHistoryScheme
+	if issue.Changelog != nil {
+		histories = issue.Changelog.Histories
+	}
+
+	histories, err := a.histories(ctx, issue.Key, issue.Changelog, histories)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := a.statusCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.report(issue.Key, issue.Fields.Created, issue.Fields.Status, histories, categories)
+}
+
+// IssueV2 computes the time report of an issue, using the v2 issue scheme.
+func (a *Analyzer) IssueV2(ctx context.Context, issue *model.IssueSchemeV2) (*IssueReportScheme, error) {
+
+	if issue == nil || issue.Fields == nil {
+		return nil, model.ErrNoIssueScheme
+	}
+
+	var histories []*model.IssueChangelogHistoryScheme
+	if issue.Changelog != nil {
+		histories = issue.Changelog.Histories
+	}
+
+	histories, err := a.histories(ctx, issue.Key, issue.Changelog, histories)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := a.statusCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.report(issue.Key, issue.Fields.Created, issue.Fields.Status, histories, categories)
+}
+
+// Issues computes the per issue and aggregate time reports of the issues.
+func (a *Analyzer) Issues(ctx context.Context, issues []*model.IssueScheme) (*ReportScheme, error) {
+
+	reports := make([]*IssueReportScheme, 0, len(issues))
+	for _, issue := range issues {
+
+		report, err := a.Issue(ctx, issue)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return Aggregate(reports), nil
+}
+
+// IssuesV2 computes the per issue and aggregate time reports of the issues, using the v2 issue scheme.
+func (a *Analyzer) IssuesV2(ctx context.Context, issues []*model.IssueSchemeV2) (*ReportScheme, error) {
+
+	reports := make([]*IssueReportScheme, 0, len(issues))
+	for _, issue := range issues {
+
+		report, err := a.IssueV2(ctx, issue)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return Aggregate(reports), nil
+}
+
+// histories fetches the full changelog when the issue changelog is missing or truncated.
+func (a *Analyzer) histories(ctx context.Context, key string, changelog *model.IssueChangelogScheme, histories []*model.IssueChangelogHistoryScheme) ([]*model.IssueChangelogHistoryScheme, error) {
+
+	if a.Changelog == nil {
+		return histories, nil
+	}
+
+	if changelog != nil && changelog.Total <= len(changelog.Histories) {
+		return histories, nil
+	}
+
+	fetched, _, err := a.Changelog.All(ctx, key, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetched, nil
+}
+
+func (a *Analyzer) report(key string, created *model.DateTimeScheme, current *model.StatusScheme, histories []*model.IssueChangelogHistoryScheme, categories map[string]string) (*IssueReportScheme, error) {
+
+	timeline, err := model.BuildIssueTimeline(histories)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := a.Calendar
+	if calendar == nil {
+		calendar = Continuous{}
+	}
+
+	now := time.Now()
+	if a.Now != nil {
+		now = a.Now()
+	}
+
+	report := &IssueReportScheme{Key: key, Categories: make(map[string]Duration)}
+
+	var statusChanges []*model.IssueTimelineEntryScheme
+	for _, entry := range timeline {
+		if entry.FieldID == "status" {
+			statusChanges = append(statusChanges, entry)
+		}
+	}
+
+	// The initial status is the source status of the first change, the current one when the status never changed.
+	status := &model.IssueFieldValueScheme{}
+	if len(statusChanges) != 0 {
+		status.ID, status.String = statusChanges[0].From, statusChanges[0].FromString
+	} else if current != nil {
+		status.ID, status.String = current.ID, current.Name
+	}
+
+	enteredAt := now
+	if created != nil {
+		enteredAt = time.Time(*created)
+	} else if len(statusChanges) != 0 {
+		enteredAt = statusChanges[0].At
+	}
+
+	report.Created = enteredAt
+
+	statuses := make(map[string]*StatusTimeScheme)
+	enter := func(status *model.IssueFieldValueScheme, at time.Time) *StatusTimeScheme {
+
+		statusKey := status.ID
+		if statusKey == "" {
+			statusKey = status.String
+		}
+
+		statusTime, ok := statuses[statusKey]
+		if !ok {
+			statusTime = &StatusTimeScheme{StatusID: status.ID, Status: status.String, Category: statusCategory(categories, status, current)}
+			statuses[statusKey] = statusTime
+			report.Statuses = append(report.Statuses, statusTime)
+		}
+
+		statusTime.Visits++
+
+		switch statusTime.Category {
+		case CategoryInProgress:
+			if report.Started == nil {
+				started := at
+				report.Started = &started
+			}
+
+			report.Done = nil
+
+		case CategoryDone:
+			done := at
+			report.Done = &done
+
+		default:
+			report.Done = nil
+		}
+
+		return statusTime
+	}
+
+	active := enter(status, enteredAt)
+	for _, change := range statusChanges {
+
+		spent := calendar.Duration(enteredAt, change.At)
+		active.Time += Duration(spent)
+		report.Categories[active.Category] += Duration(spent)
+
+		enteredAt = change.At
+		active = enter(&model.IssueFieldValueScheme{ID: change.To, String: change.ToString}, change.At)
+	}
+
+	spent := calendar.Duration(enteredAt, now)
+	active.Time += Duration(spent)
+	report.Categories[active.Category] += Duration(spent)
+
+	if report.Done != nil {
+
+		report.LeadTime = Duration(calendar.Duration(report.Created, *report.Done))
+
+		if report.Started != nil {
+			report.CycleTime = Duration(calendar.Duration(*report.Started, *report.Done))
+		}
+	}
+
+	return report, nil
+}
+
+// statusCategories returns the status categories mapping, the categories of the workflow statuses are fetched
+// on the first call when the mapping is not provided.
+func (a *Analyzer) statusCategories(ctx context.Context) (map[string]string, error) {
+
+	if a.StatusCategories != nil || a.Statuses == nil {
+		return a.StatusCategories, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.categories != nil {
+		return a.categories, nil
+	}
+
+	statuses, _, err := a.Statuses.Bulk(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]string, len(statuses))
+	for statusID, category := range model.NewStatusCategoryIndex(statuses) {
+		categories[statusID] = category.Key
+	}
+
+	a.categories = categories
+	return categories, nil
+}
+
+// statusCategory returns the category of a status, using the status categories mapping or the current status.
+func statusCategory(categories map[string]string, status *model.IssueFieldValueScheme, current *model.StatusScheme) string {
+
+	if category, ok := categories[status.ID]; ok && status.ID != "" {
+		return category
+	}
+
+	if category, ok := categories[status.String]; ok && status.String != "" {
+		return category
+	}
+
+	if current != nil && current.StatusCategory != nil &&
+		((status.ID != "" && status.ID == current.ID) || (status.ID == "" && status.String == current.Name)) {
+		return current.StatusCategory.Key
+	}
+
+	return ""
+}
+
+// Aggregate computes the aggregate statistics of the issue reports.
+func Aggregate(reports []*IssueReportScheme) *ReportScheme {
+
+	aggregate := &ReportScheme{Issues: reports, Categories: make(map[string]*StatisticsScheme)}
+
+	var (
+		statuses   = make(map[string]*StatusStatisticsScheme)
+		statusTime = make(map[string][]Duration)
+		categories = make(map[string][]Duration)
+		leadTime   []Duration
+		cycleTime  []Duration
+	)
+
+	for _, report := range reports {
+
+		for _, status := range report.Statuses {
+
+			statusKey := status.StatusID
+			if statusKey == "" {
+				statusKey = status.Status
+			}
+
+			if _, ok := statuses[statusKey]; !ok {
+				statuses[statusKey] = &StatusStatisticsScheme{StatusID: status.StatusID, Status: status.Status, Category: status.Category}
+			}
+
+			statusTime[statusKey] = append(statusTime[statusKey], status.Time)
+		}
+
+		for category, spent := range report.Categories {
+			categories[category] = append(categories[category], spent)
+		}
+
+		if report.Done != nil {
+			leadTime = append(leadTime, report.LeadTime)
+
+			if report.Started != nil {
+				cycleTime = append(cycleTime, report.CycleTime)
+			}
+		}
+	}
+
+	for statusKey, status := range statuses {
+		status.Time = statistics(statusTime[statusKey])
+		aggregate.Statuses = append(aggregate.Statuses, status)
+	}
+
+	sort.Slice(aggregate.Statuses, func(i, j int) bool {
+
+		if aggregate.Statuses[i].Status != aggregate.Statuses[j].Status {
+			return aggregate.Statuses[i].Status < aggregate.Statuses[j].Status
+		}
+
+		return aggregate.Statuses[i].StatusID < aggregate.Statuses[j].StatusID
+	})
+
+	for category, durations := range categories {
+		aggregate.Categories[category] = statistics(durations)
+	}
+
+	aggregate.LeadTime = statistics(leadTime)
+	aggregate.CycleTime = statistics(cycleTime)
+
+	return aggregate
+}
+
+func statistics(durations []Duration) *StatisticsScheme {
+
+	result := &StatisticsScheme{Count: len(durations)}
+	if len(durations) == 0 {
+		return result
+	}
+
+	sorted := append([]Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, duration := range sorted {
+		result.Total += duration
+	}
+
+	result.Mean = result.Total / Duration(len(sorted))
+	result.Median = percentile(sorted, 50)
+	result.P85 = percentile(sorted, 85)
+
+	return result
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []Duration, rank int) Duration {
+
+	index := (rank*len(sorted)+99)/100 - 1
+	if index < 0 {
+		index = 0
+	}
+
+	return sorted[index]
+}
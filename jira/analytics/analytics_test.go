@@ -0,0 +1,268 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+var _ ChangelogFetcher = (*internal.ChangelogService)(nil)
+
+type changelogFetcherMock struct {
+	histories []*model.IssueChangelogHistoryScheme
+	err       error
+	calls     []string
+}
+
+func (m *changelogFetcherMock) All(_ context.Context, issueKeyOrID string, _ int) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+	m.calls = append(m.calls, issueKeyOrID)
+	return m.histories, &model.ResponseScheme{}, m.err
+}
+
+func statusHistory(id, created, from, fromString, to, toString string) *model.IssueChangelogHistoryScheme {
+
+	return &model.IssueChangelogHistoryScheme{
+		ID:      id,
+		Created: created,
+		Items: []*model.IssueChangelogHistoryItemScheme{
+			{Field: "status", FieldID: "status", From: from, FromString: fromString, To: to, ToString: toString},
+		},
+	}
+}
+
+func issueMocked(key string, created time.Time, status *model.StatusScheme, histories ...*model.IssueChangelogHistoryScheme) *model.IssueScheme {
+
+	createdAt := model.DateTimeScheme(created)
+
+	return &model.IssueScheme{
+		Key:       key,
+		Fields:    &model.IssueFieldsScheme{Created: &createdAt, Status: status},
+		Changelog: &model.IssueChangelogScheme{Total: len(histories), Histories: histories},
+	}
+}
+
+func analyzerMocked() *Analyzer {
+
+	return &Analyzer{
+		StatusCategories: map[string]string{"1": CategoryToDo, "3": CategoryInProgress, "10001": CategoryDone},
+		Now:              func() time.Time { return time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC) },
+	}
+}
+
+func TestAnalyzer_Issue(t *testing.T) {
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	done := &model.StatusScheme{ID: "10001", Name: "Done"}
+
+	t.Run("when the issue moved through the workflow", func(t *testing.T) {
+
+		issue := issueMocked("KP-1", created, done,
+			statusHistory("2", "2024-01-04T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+		)
+
+		report, err := analyzerMocked().Issue(context.Background(), issue)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []*StatusTimeScheme{
+			{StatusID: "1", Status: "To Do", Category: CategoryToDo, Time: Duration(24 * time.Hour), Visits: 1},
+			{StatusID: "3", Status: "In Progress", Category: CategoryInProgress, Time: Duration(48 * time.Hour), Visits: 1},
+			{StatusID: "10001", Status: "Done", Category: CategoryDone, Time: Duration(144 * time.Hour), Visits: 1},
+		}, report.Statuses)
+
+		assert.Equal(t, Duration(72*time.Hour), report.LeadTime)
+		assert.Equal(t, Duration(48*time.Hour), report.CycleTime)
+		assert.Equal(t, Duration(48*time.Hour), report.Categories[CategoryInProgress])
+	})
+
+	t.Run("when the issue was reopened", func(t *testing.T) {
+
+		issue := issueMocked("KP-2", created, &model.StatusScheme{ID: "3", Name: "In Progress"},
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+			statusHistory("2", "2024-01-03T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+			statusHistory("3", "2024-01-05T00:00:00.000+0000", "10001", "Done", "3", "In Progress"),
+		)
+
+		report, err := analyzerMocked().Issue(context.Background(), issue)
+		assert.NoError(t, err)
+
+		assert.Nil(t, report.Done)
+		assert.Equal(t, Duration(0), report.LeadTime)
+		assert.Equal(t, 2, report.Statuses[1].Visits)
+		assert.Equal(t, Duration(6*24*time.Hour), report.Statuses[1].Time)
+	})
+
+	t.Run("when the status never changed", func(t *testing.T) {
+
+		issue := issueMocked("KP-3", created, &model.StatusScheme{ID: "5", Name: "Backlog",
+			StatusCategory: &model.StatusCategoryScheme{Key: CategoryToDo}})
+
+		report, err := analyzerMocked().Issue(context.Background(), issue)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []*StatusTimeScheme{
+			{StatusID: "5", Status: "Backlog", Category: CategoryToDo, Time: Duration(9 * 24 * time.Hour), Visits: 1},
+		}, report.Statuses)
+	})
+
+	t.Run("when the changelog is truncated", func(t *testing.T) {
+
+		fetcher := &changelogFetcherMock{histories: []*model.IssueChangelogHistoryScheme{
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "10001", "Done"),
+		}}
+
+		issue := issueMocked("KP-4", created, done)
+		issue.Changelog.Total = 150
+
+		analyzer := analyzerMocked()
+		analyzer.Changelog = fetcher
+
+		report, err := analyzer.Issue(context.Background(), issue)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"KP-4"}, fetcher.calls)
+		assert.Equal(t, Duration(24*time.Hour), report.LeadTime)
+	})
+
+	t.Run("when the changelog cannot be fetched", func(t *testing.T) {
+
+		analyzer := analyzerMocked()
+		analyzer.Changelog = &changelogFetcherMock{err: errors.New("client: no authorization")}
+
+		issue := issueMocked("KP-5", created, done)
+		issue.Changelog = nil
+
+		_, err := analyzer.Issue(context.Background(), issue)
+		assert.EqualError(t, err, "client: no authorization")
+	})
+
+	t.Run("when the issue is not provided", func(t *testing.T) {
+		_, err := analyzerMocked().Issue(context.Background(), nil)
+		assert.ErrorIs(t, err, model.ErrNoIssueScheme)
+	})
+}
+
+func TestAnalyzer_Issues(t *testing.T) {
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	done := &model.StatusScheme{ID: "10001", Name: "Done"}
+
+	issues := []*model.IssueScheme{
+		issueMocked("KP-1", created, done,
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+			statusHistory("2", "2024-01-03T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+		),
+		issueMocked("KP-2", created, done,
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+			statusHistory("2", "2024-01-05T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+		),
+	}
+
+	report, err := analyzerMocked().Issues(context.Background(), issues)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &StatisticsScheme{
+		Count:  2,
+		Total:  Duration(4 * 24 * time.Hour),
+		Mean:   Duration(2 * 24 * time.Hour),
+		Median: Duration(24 * time.Hour),
+		P85:    Duration(3 * 24 * time.Hour),
+	}, report.CycleTime)
+
+	assert.Equal(t, 2, report.LeadTime.Count)
+	assert.Equal(t, []string{"Done", "In Progress", "To Do"}, []string{
+		report.Statuses[0].Status, report.Statuses[1].Status, report.Statuses[2].Status,
+	})
+
+	buffer := new(bytes.Buffer)
+	assert.NoError(t, report.WriteCSV(buffer))
+	assert.Equal(t, "key,statusId,status,category,hours,visits", strings.Split(buffer.String(), "\n")[0])
+	assert.Contains(t, buffer.String(), "KP-2,3,In Progress,indeterminate,72.00,1\n")
+
+	buffer.Reset()
+	assert.NoError(t, report.WriteIssuesCSV(buffer))
+	assert.Contains(t, buffer.String(), "KP-1,2024-01-01T00:00:00Z,2024-01-02T00:00:00Z,2024-01-03T00:00:00Z,48.00,24.00,24.00,24.00,168.00\n")
+
+	buffer.Reset()
+	assert.NoError(t, report.WriteJSON(buffer))
+	assert.Contains(t, buffer.String(), `"cycleTime": 86400`)
+}
+
+var _ StatusFetcher = (*internal.WorkflowStatusService)(nil)
+
+type statusesMocked struct {
+	calls int
+	err   error
+}
+
+func (s *statusesMocked) Bulk(ctx context.Context) ([]*model.StatusDetailScheme, *model.ResponseScheme, error) {
+
+	s.calls++
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+
+	return []*model.StatusDetailScheme{
+		{ID: "1", Name: "To Do", StatusCategory: &model.StatusCategoryScheme{Key: CategoryToDo}},
+		{ID: "3", Name: "In Progress", StatusCategory: &model.StatusCategoryScheme{Key: CategoryInProgress}},
+		{ID: "10001", Name: "Done", StatusCategory: &model.StatusCategoryScheme{Key: CategoryDone}},
+	}, nil, nil
+}
+
+func TestAnalyzer_Issues_Statuses(t *testing.T) {
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	done := &model.StatusScheme{ID: "10001", Name: "Done"}
+
+	issues := []*model.IssueScheme{
+		issueMocked("KP-1", created, done,
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+			statusHistory("2", "2024-01-03T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+		),
+		issueMocked("KP-2", created, done,
+			statusHistory("1", "2024-01-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+			statusHistory("2", "2024-01-05T00:00:00.000+0000", "3", "In Progress", "10001", "Done"),
+		),
+	}
+
+	t.Run("when the categories are fetched from the workflow statuses", func(t *testing.T) {
+
+		statuses := &statusesMocked{}
+		analyzer := &Analyzer{Statuses: statuses, Now: analyzerMocked().Now}
+
+		report, err := analyzer.Issues(context.Background(), issues)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, statuses.calls)
+		assert.Nil(t, analyzer.StatusCategories)
+
+		if assert.NotNil(t, report.CycleTime) {
+			assert.Equal(t, 2, report.CycleTime.Count)
+		}
+	})
+
+	t.Run("when the statuses cannot be fetched", func(t *testing.T) {
+
+		analyzer := &Analyzer{Statuses: &statusesMocked{err: errors.New("error, request failed. Please fix me")}}
+
+		_, err := analyzer.Issues(context.Background(), issues)
+		assert.EqualError(t, err, "error, request failed. Please fix me")
+	})
+
+	t.Run("when the mapping is provided the statuses are not fetched", func(t *testing.T) {
+
+		statuses := &statusesMocked{}
+		analyzer := analyzerMocked()
+		analyzer.Statuses = statuses
+
+		_, err := analyzer.Issues(context.Background(), issues)
+		assert.NoError(t, err)
+		assert.Zero(t, statuses.calls)
+	})
+}
@@ -0,0 +1,100 @@
+package analytics
+
+import "time"
+
+// Calendar measures the time elapsed between two instants.
+type Calendar interface {
+	// Duration returns the time counted between the instants, it's zero when "to" is before "from".
+	Duration(from, to time.Time) time.Duration
+}
+
+// Continuous is the calendar counting the wall-clock time, including nights, weekends and holidays.
+type Continuous struct{}
+
+// Duration returns the wall-clock time elapsed between the instants.
+func (Continuous) Duration(from, to time.Time) time.Duration {
+
+	if !to.After(from) {
+		return 0
+	}
+
+	return to.Sub(from)
+}
+
+// WorkingHours is the calendar counting the time within the working hours of the working days only.
+type WorkingHours struct {
+	Location *time.Location // The time zone of the working hours, UTC when nil.
+	Start    time.Duration  // The start of the working day, as an offset from midnight, e.g. 9 * time.Hour.
+	End      time.Duration  // The end of the working day, as an offset from midnight, e.g. 17 * time.Hour.
+	Days     []time.Weekday // The working days, Monday to Friday when empty.
+	Holidays []time.Time    // The non-working dates, only the year, month and day are used.
+}
+
+// NewWorkingHours creates a Monday to Friday working hours calendar.
+func NewWorkingHours(location *time.Location, start, end time.Duration, holidays ...time.Time) *WorkingHours {
+	return &WorkingHours{Location: location, Start: start, End: end, Holidays: holidays}
+}
+
+// Duration returns the working time elapsed between the instants.
+func (w *WorkingHours) Duration(from, to time.Time) time.Duration {
+
+	if !to.After(from) || w.End <= w.Start {
+		return 0
+	}
+
+	location := w.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	from, to = from.In(location), to.In(location)
+
+	var total time.Duration
+	for day := midnight(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+
+		if !w.isWorkingDay(day) {
+			continue
+		}
+
+		start, end := day.Add(w.Start), day.Add(w.End)
+
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+
+	return total
+}
+
+func (w *WorkingHours) isWorkingDay(day time.Time) bool {
+
+	for _, holiday := range w.Holidays {
+		if holiday.Year() == day.Year() && holiday.YearDay() == day.YearDay() {
+			return false
+		}
+	}
+
+	if len(w.Days) == 0 {
+		return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+	}
+
+	for _, weekday := range w.Days {
+		if day.Weekday() == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+func midnight(instant time.Time) time.Time {
+	return time.Date(instant.Year(), instant.Month(), instant.Day(), 0, 0, 0, 0, instant.Location())
+}
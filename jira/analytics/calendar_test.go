@@ -0,0 +1,80 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinuous_Duration(t *testing.T) {
+
+	from := time.Date(2024, 1, 5, 16, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 74*time.Hour, Continuous{}.Duration(from, from.Add(74*time.Hour)))
+	assert.Equal(t, time.Duration(0), Continuous{}.Duration(from, from.Add(-time.Hour)))
+}
+
+func TestWorkingHours_Duration(t *testing.T) {
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Skip("the time zone database is not available")
+	}
+
+	testCases := []struct {
+		name     string
+		calendar *WorkingHours
+		from, to time.Time
+		want     time.Duration
+	}{
+		{
+			name:     "when the interval is within a working day",
+			calendar: NewWorkingHours(nil, 9*time.Hour, 17*time.Hour),
+			from:     time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 2, 12, 30, 0, 0, time.UTC),
+			want:     150 * time.Minute,
+		},
+		{
+			name:     "when the interval spans a weekend",
+			calendar: NewWorkingHours(nil, 9*time.Hour, 17*time.Hour),
+			from:     time.Date(2024, 1, 5, 16, 0, 0, 0, time.UTC), // Friday
+			to:       time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC), // Monday
+			want:     2 * time.Hour,
+		},
+		{
+			name:     "when the interval contains a holiday",
+			calendar: NewWorkingHours(nil, 9*time.Hour, 17*time.Hour, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+			from:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			want:     16 * time.Hour,
+		},
+		{
+			name:     "when the calendar uses custom working days",
+			calendar: &WorkingHours{Start: 8 * time.Hour, End: 12 * time.Hour, Days: []time.Weekday{time.Saturday}},
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			want:     4 * time.Hour,
+		},
+		{
+			name:     "when the calendar uses a time zone",
+			calendar: NewWorkingHours(madrid, 9*time.Hour, 17*time.Hour),
+			from:     time.Date(2024, 1, 2, 7, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			want:     time.Hour,
+		},
+		{
+			name:     "when the interval is reversed",
+			calendar: NewWorkingHours(nil, 9*time.Hour, 17*time.Hour),
+			from:     time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want:     0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.calendar.Duration(testCase.from, testCase.to))
+		})
+	}
+}
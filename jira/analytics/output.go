@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// WriteJSON writes the report as indented JSON, the durations are encoded as seconds.
+func (r *ReportScheme) WriteJSON(w io.Writer) error {
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes a row per issue and status, including a header row.
+// The durations are written as hours.
+func (r *ReportScheme) WriteCSV(w io.Writer) error {
+
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"key", "statusId", "status", "category", "hours", "visits"}); err != nil {
+		return err
+	}
+
+	for _, issue := range r.Issues {
+		for _, status := range issue.Statuses {
+
+			record := []string{
+				issue.Key, status.StatusID, status.Status, status.Category, formatHours(status.Time),
+				strconv.Itoa(status.Visits),
+			}
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteIssuesCSV writes a row per issue with the lead and cycle times, including a header row.
+// The durations are written as hours.
+func (r *ReportScheme) WriteIssuesCSV(w io.Writer) error {
+
+	writer := csv.NewWriter(w)
+
+	header := []string{"key", "created", "started", "done", "leadTimeHours", "cycleTimeHours",
+		"toDoHours", "inProgressHours", "doneHours"}
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, issue := range r.Issues {
+
+		record := []string{
+			issue.Key, issue.Created.Format(time.RFC3339), formatTime(issue.Started), formatTime(issue.Done),
+			formatHours(issue.LeadTime), formatHours(issue.CycleTime), formatHours(issue.Categories[CategoryToDo]),
+			formatHours(issue.Categories[CategoryInProgress]), formatHours(issue.Categories[CategoryDone]),
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatHours(duration Duration) string {
+	return strconv.FormatFloat(duration.Hours(), 'f', 2, 64)
+}
+
+func formatTime(instant *time.Time) string {
+
+	if instant == nil {
+		return ""
+	}
+
+	return instant.Format(time.RFC3339)
+}
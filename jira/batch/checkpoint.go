@@ -0,0 +1,127 @@
+package batch
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// Checkpoint records the successful operations, so the next run skips them.
+type Checkpoint interface {
+	// Completed returns the IDs of the operations already succeeded.
+	Completed() (map[string]bool, error)
+	// Save records the result of a successful operation, it's called concurrently.
+	Save(result *ResultScheme) error
+}
+
+// MemoryCheckpoint is the in-memory checkpoint, useful to re-run the failed operations of a process.
+type MemoryCheckpoint struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+// Completed returns the IDs of the operations already succeeded.
+func (m *MemoryCheckpoint) Completed() (map[string]bool, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	completed := make(map[string]bool, len(m.ids))
+	for id := range m.ids {
+		completed[id] = true
+	}
+
+	return completed, nil
+}
+
+// Save records the result of a successful operation.
+func (m *MemoryCheckpoint) Save(result *ResultScheme) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ids == nil {
+		m.ids = make(map[string]bool)
+	}
+
+	m.ids[result.ID] = true
+	return nil
+}
+
+// FileCheckpoint is the checkpoint appending the successful results to a JSON lines file.
+type FileCheckpoint struct {
+	Path string // The path of the file, created on the first save.
+
+	mu sync.Mutex
+}
+
+// NewFileCheckpoint creates a checkpoint stored on the file provided.
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{Path: path}
+}
+
+// Completed reads the IDs of the operations already succeeded, it's empty when the file doesn't exist.
+func (f *FileCheckpoint) Completed() (map[string]bool, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	completed := make(map[string]bool)
+
+	file, err := os.Open(f.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return completed, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		result := new(ResultScheme)
+		if err := json.Unmarshal(scanner.Bytes(), result); err != nil {
+			// A truncated last line is expected when the previous run was killed.
+			continue
+		}
+
+		completed[result.ID] = true
+	}
+
+	return completed, scanner.Err()
+}
+
+// Save appends the result to the file.
+func (f *FileCheckpoint) Save(result *ResultScheme) error {
+
+	line, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,289 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Executor runs the operations concurrently, retrying the throttled and transient failures.
+type Executor struct {
+	// Concurrency is the maximum number of operations in flight, 4 when zero.
+	Concurrency int
+	// RateLimit is the maximum number of calls per second, including the retries, unlimited when zero.
+	RateLimit float64
+	// MaxRetries is the maximum number of retries of an operation, zero to disable the retries.
+	MaxRetries int
+	// Backoff returns the wait before a retry, the attempt starts at 1. It defaults to an exponential backoff
+	// starting at one second and capped at 30 seconds. The Retry-After header takes precedence when it's returned.
+	Backoff func(attempt int) time.Duration
+	// Retryable decides whether a failed call is retried. It defaults to the 429 responses of every operation,
+	// and the 5xx responses and the network errors of the idempotent operations, so a call that may have
+	// been applied (e.g. a comment added) isn't repeated.
+	Retryable func(response *model.ResponseScheme, err error) bool
+	// Checkpoint records the successful operations and skips them on the next runs, optional.
+	Checkpoint Checkpoint
+	// OnResult is called after each operation completes, it's called concurrently, optional.
+	OnResult func(result *ResultScheme)
+}
+
+// Run executes the operations and returns the report of the results, in the order of the operations.
+//
+// The failed operations don't interrupt the run, the error is only returned when the checkpoint can't be read
+// or saved, or the context is done, the report includes the operations completed so far in both cases.
+// The duplicated operation IDs are suffixed with their occurrence (e.g. "comment:KP-1#2"), so the checkpoints
+// remain valid as long as the operations are provided in the same order.
+func (e *Executor) Run(ctx context.Context, operations []*Operation) (*ReportScheme, error) {
+
+	report := &ReportScheme{Results: make([]*ResultScheme, len(operations))}
+	ids := uniqueIDs(operations)
+
+	completed := map[string]bool{}
+	if e.Checkpoint != nil {
+
+		var err error
+		if completed, err = e.Checkpoint.Completed(); err != nil {
+			return nil, fmt.Errorf("jira: unable to read the batch checkpoint: %w", err)
+		}
+	}
+
+	for index, id := range ids {
+
+		status := StatusCancelled
+		if completed[id] {
+			status = StatusSkipped
+		}
+
+		report.Results[index] = &ResultScheme{ID: id, Status: status}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limiter := newLimiter(e.RateLimit)
+	defer limiter.stop()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		runErr   error
+		indexes  = make(chan int)
+		failWith = func(err error) {
+			once.Do(func() {
+				runErr = err
+				cancel()
+			})
+		}
+	)
+
+	concurrency := e.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	for worker := 0; worker < concurrency; worker++ {
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+
+				result := report.Results[index]
+				if !e.execute(ctx, limiter, operations[index], result) {
+					continue
+				}
+
+				if result.Status == StatusSucceeded && e.Checkpoint != nil {
+					if err := e.Checkpoint.Save(result); err != nil {
+						failWith(fmt.Errorf("jira: unable to save the batch checkpoint: %w", err))
+					}
+				}
+
+				if e.OnResult != nil {
+					e.OnResult(result)
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for index, result := range report.Results {
+
+		if result.Status == StatusSkipped || operations[index] == nil || operations[index].Do == nil {
+			if result.Status != StatusSkipped {
+				result.Status, result.Err, result.Error = StatusFailed, model.ErrNoBatchOperation, model.ErrNoBatchOperation.Error()
+			}
+			continue
+		}
+
+		select {
+		case indexes <- index:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	report.count()
+
+	if runErr != nil {
+		return report, runErr
+	}
+
+	return report, ctx.Err()
+}
+
+// execute runs the operation with its retries, it returns false when the context was done before completing it.
+func (e *Executor) execute(ctx context.Context, limiter *limiter, operation *Operation, result *ResultScheme) bool {
+
+	started := time.Now()
+	defer func() { result.Duration = time.Since(started) }()
+
+	for {
+
+		if err := limiter.wait(ctx); err != nil {
+			return false
+		}
+
+		response, err := operation.Do(ctx)
+		result.Attempts++
+		result.StatusCode, result.Err, result.Error, result.APIError = 0, err, "", nil
+
+		if response != nil {
+			result.StatusCode = response.Code
+		}
+
+		if err == nil {
+			result.Status = StatusSucceeded
+			return true
+		}
+
+		if ctx.Err() != nil {
+			return false
+		}
+
+		result.Error = err.Error()
+		result.APIError = parseAPIError(response)
+
+		if result.Attempts > e.MaxRetries || !e.retryable(operation, response, err) {
+			result.Status = StatusFailed
+			return true
+		}
+
+		timer := time.NewTimer(e.wait(response, result.Attempts))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+}
+
+func (e *Executor) retryable(operation *Operation, response *model.ResponseScheme, err error) bool {
+
+	if e.Retryable != nil {
+		return e.Retryable(response, err)
+	}
+
+	if response != nil && response.Code != 0 {
+
+		if response.Code == http.StatusTooManyRequests {
+			return true
+		}
+
+		return operation.Idempotent && response.Code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return operation.Idempotent && errors.As(err, &netErr)
+}
+
+func (e *Executor) wait(response *model.ResponseScheme, attempt int) time.Duration {
+
+	if response != nil && response.Response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	if e.Backoff != nil {
+		return e.Backoff(attempt)
+	}
+
+	wait := time.Second << (attempt - 1)
+	if wait > 30*time.Second || wait <= 0 {
+		wait = 30 * time.Second
+	}
+
+	return wait
+}
+
+func uniqueIDs(operations []*Operation) []string {
+
+	ids := make([]string, len(operations))
+	seen := make(map[string]int, len(operations))
+
+	for index, operation := range operations {
+
+		id := ""
+		if operation != nil {
+			id = operation.ID
+		}
+
+		seen[id]++
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%v#%v", id, seen[id])
+		}
+
+		ids[index] = id
+	}
+
+	return ids
+}
+
+// limiter spaces the calls evenly to respect the rate limit.
+type limiter struct {
+	ticker *time.Ticker
+}
+
+func newLimiter(rate float64) *limiter {
+
+	if rate <= 0 {
+		return &limiter{}
+	}
+
+	return &limiter{ticker: time.NewTicker(time.Duration(float64(time.Second) / rate))}
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+
+	if l.ticker == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.ticker.C:
+		return nil
+	}
+}
+
+func (l *limiter) stop() {
+
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
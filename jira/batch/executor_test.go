@@ -0,0 +1,203 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func responseMocked(code int, body string) *model.ResponseScheme {
+
+	response := &model.ResponseScheme{Code: code, Response: &http.Response{StatusCode: code, Header: http.Header{}}}
+	response.Bytes.WriteString(body)
+
+	return response
+}
+
+func TestExecutor_Run(t *testing.T) {
+
+	var throttled int32
+
+	operations := []*Operation{
+		Call("ok", func(ctx context.Context) (*model.ResponseScheme, error) {
+			return responseMocked(http.StatusNoContent, ""), nil
+		}),
+		Call("throttled", func(ctx context.Context) (*model.ResponseScheme, error) {
+			if atomic.AddInt32(&throttled, 1) < 3 {
+				return responseMocked(http.StatusTooManyRequests, ""), model.ErrInvalidStatusCode
+			}
+			return responseMocked(http.StatusOK, ""), nil
+		}),
+		Call("invalid", func(ctx context.Context) (*model.ResponseScheme, error) {
+			return responseMocked(http.StatusBadRequest, `{"errorMessages":[],"errors":{"summary":"Field required."}}`), model.ErrBadRequest
+		}),
+		Call("ok", func(ctx context.Context) (*model.ResponseScheme, error) {
+			return responseMocked(http.StatusCreated, ""), nil
+		}),
+		nil,
+	}
+
+	executor := &Executor{Concurrency: 2, MaxRetries: 3, Backoff: func(int) time.Duration { return time.Millisecond }}
+
+	report, err := executor.Run(context.Background(), operations)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, 3, report.Succeeded)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, 2, report.Retries)
+
+	assert.Equal(t, "ok#2", report.Results[3].ID)
+	assert.Equal(t, 3, report.Results[1].Attempts)
+
+	invalid := report.Results[2]
+	assert.Equal(t, StatusFailed, invalid.Status)
+	assert.Equal(t, 1, invalid.Attempts)
+	assert.Equal(t, http.StatusBadRequest, invalid.StatusCode)
+	assert.ErrorIs(t, invalid.Err, model.ErrBadRequest)
+	assert.Equal(t, map[string]string{"summary": "Field required."}, invalid.APIError.Errors)
+
+	assert.ErrorIs(t, report.Results[4].Err, model.ErrNoBatchOperation)
+	assert.Equal(t, []string{"invalid", ""}, report.Incomplete())
+	assert.Len(t, report.Failures(), 2)
+}
+
+func TestExecutor_Run_Checkpoint(t *testing.T) {
+
+	var calls int32
+
+	operation := func(id string, fail bool) *Operation {
+		return Call(id, func(ctx context.Context) (*model.ResponseScheme, error) {
+			atomic.AddInt32(&calls, 1)
+			if fail {
+				return responseMocked(http.StatusInternalServerError, ""), model.ErrInternal
+			}
+			return responseMocked(http.StatusOK, ""), nil
+		})
+	}
+
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint.jsonl"))
+	executor := &Executor{Checkpoint: checkpoint}
+
+	report, err := executor.Run(context.Background(), []*Operation{operation("a", false), operation("b", true)})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 1, report.Failed)
+
+	report, err = executor.Run(context.Background(), []*Operation{operation("a", false), operation("b", false)})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	completed, err := checkpoint.Completed()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, completed)
+
+	memory := &MemoryCheckpoint{}
+	assert.NoError(t, memory.Save(&ResultScheme{ID: "a"}))
+	completed, err = memory.Completed()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a": true}, completed)
+}
+
+func TestExecutor_Run_Cancelled(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	operations := []*Operation{
+		Call("first", func(ctx context.Context) (*model.ResponseScheme, error) {
+			cancel()
+			return nil, ctx.Err()
+		}),
+		Call("second", func(ctx context.Context) (*model.ResponseScheme, error) {
+			return responseMocked(http.StatusOK, ""), nil
+		}),
+	}
+
+	report, err := (&Executor{Concurrency: 1}).Run(ctx, operations)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, report.Cancelled)
+	assert.Equal(t, []string{"first", "second"}, report.Incomplete())
+}
+
+func TestExecutor_Run_RateLimit(t *testing.T) {
+
+	operations := make([]*Operation, 3)
+	for index := range operations {
+		operations[index] = Call("op", func(ctx context.Context) (*model.ResponseScheme, error) {
+			return responseMocked(http.StatusOK, ""), nil
+		})
+	}
+
+	started := time.Now()
+
+	report, err := (&Executor{Concurrency: 3, RateLimit: 50}).Run(context.Background(), operations)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Succeeded)
+	assert.GreaterOrEqual(t, time.Since(started), 60*time.Millisecond)
+}
+
+func TestExecutor_retryable(t *testing.T) {
+
+	executor := &Executor{}
+	idempotent, created := &Operation{Idempotent: true}, &Operation{}
+	timeout := &net.OpError{Op: "dial", Err: errors.New("i/o timeout")}
+
+	assert.True(t, executor.retryable(idempotent, responseMocked(http.StatusTooManyRequests, ""), model.ErrInvalidStatusCode))
+	assert.True(t, executor.retryable(created, responseMocked(http.StatusTooManyRequests, ""), model.ErrInvalidStatusCode))
+	assert.True(t, executor.retryable(idempotent, responseMocked(http.StatusBadGateway, ""), model.ErrInvalidStatusCode))
+	assert.False(t, executor.retryable(created, responseMocked(http.StatusBadGateway, ""), model.ErrInvalidStatusCode))
+	assert.True(t, executor.retryable(idempotent, nil, timeout))
+	assert.False(t, executor.retryable(created, nil, timeout))
+	assert.False(t, executor.retryable(idempotent, responseMocked(http.StatusNotFound, ""), model.ErrNotFound))
+	assert.False(t, executor.retryable(idempotent, nil, errors.New("error, unable to create the http request")))
+
+	throttled := responseMocked(http.StatusTooManyRequests, "")
+	throttled.Header.Set("Retry-After", "7")
+
+	assert.Equal(t, 7*time.Second, executor.wait(throttled, 1))
+	assert.Equal(t, 4*time.Second, executor.wait(nil, 3))
+	assert.Equal(t, 30*time.Second, executor.wait(nil, 10))
+}
+
+func TestOperations(t *testing.T) {
+
+	comment, commentV2, err := internal.NewCommentService(nil, "3")
+	assert.NoError(t, err)
+
+	remoteLink, err := internal.NewRemoteLinkService(nil, "3")
+	assert.NoError(t, err)
+
+	addComment := AddComment(comment.Add, "KP-1", &model.CommentPayloadScheme{})
+	assert.Equal(t, "comment:KP-1", addComment.ID)
+	assert.False(t, addComment.Idempotent)
+
+	addCommentV2 := AddComment(commentV2.Add, "KP-2", &model.CommentPayloadSchemeV2{Body: "Hello"})
+	assert.Equal(t, "comment:KP-2", addCommentV2.ID)
+	assert.False(t, addCommentV2.Idempotent)
+
+	createRemoteLink := CreateRemoteLink(remoteLink.Create, "KP-1", &model.RemoteLinkScheme{GlobalID: "system=http://www.mycompany.com/support&id=1"})
+	assert.Equal(t, "remote-link:KP-1:system=http://www.mycompany.com/support&id=1", createRemoteLink.ID)
+	assert.True(t, createRemoteLink.Idempotent)
+	assert.False(t, CreateRemoteLink(remoteLink.Create, "KP-1", &model.RemoteLinkScheme{}).Idempotent)
+
+	var created *model.IssueCommentScheme
+	operation := CallWithResult("comment", func(ctx context.Context) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+		return &model.IssueCommentScheme{ID: "10001"}, responseMocked(http.StatusCreated, ""), nil
+	}, func(result *model.IssueCommentScheme) { created = result })
+
+	_, err = operation.Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", created.ID)
+}
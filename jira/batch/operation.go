@@ -0,0 +1,126 @@
+// Package batch runs many Jira calls concurrently, for the operations without a native bulk endpoint
+// (comments, worklogs, remote links, issue properties, watchers...).
+//
+// The executor bounds the concurrency and the request rate, retries the throttled and transient failures,
+// and returns a report with the result of each operation, including the error returned by the API.
+// The successful operations can be recorded on a checkpoint, so an interrupted run can be resumed:
+//
+//	operations := []*batch.Operation{
+//		batch.AddComment(client.Issue.Comment.Add, "KP-1", comment),
+//		batch.SetProperty(client.Issue.Property.Set, "KP-2", "sprint-review", value),
+//		batch.Call("watch:KP-3", func(ctx context.Context) (*models.ResponseScheme, error) {
+//			return client.Issue.Watcher.Add(ctx, "KP-3", accountID)
+//		}),
+//	}
+//
+//	executor := &batch.Executor{Concurrency: 8, RateLimit: 10, MaxRetries: 3, Checkpoint: batch.NewFileCheckpoint("run.jsonl")}
+//	report, err := executor.Run(ctx, operations)
+package batch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Operation represents a single call executed by the batch executor.
+type Operation struct {
+	// ID identifies the operation on the report and the checkpoint, it must be unique within a run.
+	ID string
+	// Do performs the call, it's invoked once per attempt.
+	Do func(ctx context.Context) (*model.ResponseScheme, error)
+	// Idempotent reports whether the call can be repeated without side effects (e.g. a GET, PUT or DELETE),
+	// only the idempotent operations are retried on the 5xx responses and the network errors.
+	Idempotent bool
+}
+
+// Call creates an operation from a call returning only the response.
+// The operation isn't idempotent, set Operation.Idempotent to retry it on the transient failures.
+func Call(id string, do func(ctx context.Context) (*model.ResponseScheme, error)) *Operation {
+	return &Operation{ID: id, Do: do}
+}
+
+// CallWithResult creates an operation from a call returning a result, the result is passed to the handler
+// after each successful attempt, the handler can be nil. The operation isn't idempotent, as on Call.
+func CallWithResult[T any](id string, do func(ctx context.Context) (T, *model.ResponseScheme, error), handler func(T)) *Operation {
+
+	return &Operation{
+		ID: id,
+		Do: func(ctx context.Context) (*model.ResponseScheme, error) {
+
+			result, response, err := do(ctx)
+			if err == nil && handler != nil {
+				handler(result)
+			}
+
+			return response, err
+		},
+	}
+}
+
+// AddComment creates the operation adding a comment to an issue, the add function is the Add method of the
+// ADF or rich text comment service, e.g. client.Issue.Comment.Add.
+func AddComment[P, C any](add func(ctx context.Context, issueKeyOrID string, payload P, expand []string) (C, *model.ResponseScheme, error), issueKeyOrID string, payload P) *Operation {
+
+	return CallWithResult(operationID("comment", issueKeyOrID), func(ctx context.Context) (C, *model.ResponseScheme, error) {
+		return add(ctx, issueKeyOrID, payload, nil)
+	}, nil)
+}
+
+// CreateRemoteLink creates the operation creating or updating (using the global ID) a remote link of an issue,
+// the create function is the Create method of the remote link service, e.g. client.Issue.Link.Remote.Create.
+// The operation is idempotent when the payload contains a global ID.
+func CreateRemoteLink(create func(ctx context.Context, issueKeyOrID string, payload *model.RemoteLinkScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error), issueKeyOrID string, payload *model.RemoteLinkScheme) *Operation {
+
+	id := operationID("remote-link", issueKeyOrID)
+	if payload != nil && payload.GlobalID != "" {
+		id = operationID("remote-link", issueKeyOrID, payload.GlobalID)
+	}
+
+	operation := CallWithResult(id, func(ctx context.Context) (*model.RemoteLinkIdentify, *model.ResponseScheme, error) {
+		return create(ctx, issueKeyOrID, payload)
+	}, nil)
+
+	operation.Idempotent = payload != nil && payload.GlobalID != ""
+	return operation
+}
+
+// SetProperty creates the operation setting a property of an issue, the set function is the Set method of
+// the issue property service, e.g. client.Issue.Property.Set. The operation is idempotent.
+func SetProperty(set func(ctx context.Context, issueKeyOrID, propertyKey string, payload interface{}) (*model.ResponseScheme, error), issueKeyOrID, propertyKey string, payload interface{}) *Operation {
+
+	operation := Call(operationID("property", issueKeyOrID, propertyKey), func(ctx context.Context) (*model.ResponseScheme, error) {
+		return set(ctx, issueKeyOrID, propertyKey, payload)
+	})
+
+	operation.Idempotent = true
+	return operation
+}
+
+// AddWatcher creates the operation adding a watcher to an issue, the add function is the Add method of
+// the watcher service, e.g. client.Issue.Watcher.Add. The operation is idempotent, a watcher is added once.
+func AddWatcher(add func(ctx context.Context, issueKeyOrID string, accountID ...string) (*model.ResponseScheme, error), issueKeyOrID, accountID string) *Operation {
+
+	operation := Call(operationID("watcher", issueKeyOrID, accountID), func(ctx context.Context) (*model.ResponseScheme, error) {
+		return add(ctx, issueKeyOrID, accountID)
+	})
+
+	operation.Idempotent = true
+	return operation
+}
+
+// AddWorklog creates the operation adding a worklog to an issue, the add function is the Add method of the
+// ADF or rich text worklog service, e.g. client.Issue.Worklog.Add.
+func AddWorklog[P, W any](add func(ctx context.Context, issueKeyOrID string, payload P, options *model.WorklogOptionsScheme) (W, *model.ResponseScheme, error), issueKeyOrID string, payload P, options *model.WorklogOptionsScheme) *Operation {
+
+	return CallWithResult(operationID("worklog", issueKeyOrID), func(ctx context.Context) (W, *model.ResponseScheme, error) {
+		return add(ctx, issueKeyOrID, payload, options)
+	}, nil)
+}
+
+// operationID joins the kind and the keys of the operation, the executor suffixes the duplicated IDs.
+func operationID(kind string, keys ...string) string {
+	return fmt.Sprintf("%v:%v", kind, strings.Join(keys, ":"))
+}
@@ -0,0 +1,114 @@
+package batch
+
+import (
+	"encoding/json"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The statuses of the operation results.
+const (
+	StatusSucceeded = "succeeded" // The operation succeeded.
+	StatusFailed    = "failed"    // The operation failed after the retries.
+	StatusSkipped   = "skipped"   // The operation succeeded on a previous run, according to the checkpoint.
+	StatusCancelled = "cancelled" // The operation wasn't completed before the context was done.
+)
+
+// APIErrorScheme represents the error collection returned by the Jira REST API.
+type APIErrorScheme struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"` // The error messages.
+	Errors        map[string]string `json:"errors,omitempty"`        // The errors keyed by field.
+}
+
+// ResultScheme represents the result of an operation.
+type ResultScheme struct {
+	ID         string          `json:"id"`                   // The ID of the operation.
+	Status     string          `json:"status"`               // The status of the operation.
+	Attempts   int             `json:"attempts"`             // The number of calls made, including the retries.
+	StatusCode int             `json:"statusCode,omitempty"` // The HTTP status code of the last call.
+	Error      string          `json:"error,omitempty"`      // The error of the last call.
+	APIError   *APIErrorScheme `json:"apiError,omitempty"`   // The error collection of the last call, when returned.
+	Duration   time.Duration   `json:"duration"`             // The time spent on the operation, including the retry waits.
+	Err        error           `json:"-"`                    // The error of the last call, e.g. models.ErrNotFound.
+}
+
+// ReportScheme represents the results of a batch run.
+type ReportScheme struct {
+	Total     int             `json:"total"`     // The number of operations.
+	Succeeded int             `json:"succeeded"` // The number of operations succeeded.
+	Failed    int             `json:"failed"`    // The number of operations failed.
+	Skipped   int             `json:"skipped"`   // The number of operations skipped by the checkpoint.
+	Cancelled int             `json:"cancelled"` // The number of operations not completed.
+	Retries   int             `json:"retries"`   // The number of retries made.
+	Results   []*ResultScheme `json:"results"`   // The results, in the order of the operations.
+}
+
+// Failures returns the results of the failed operations.
+func (r *ReportScheme) Failures() []*ResultScheme {
+
+	var failures []*ResultScheme
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			failures = append(failures, result)
+		}
+	}
+
+	return failures
+}
+
+// Incomplete returns the IDs of the failed and cancelled operations, the operations to run again.
+func (r *ReportScheme) Incomplete() []string {
+
+	var ids []string
+	for _, result := range r.Results {
+		if result.Status == StatusFailed || result.Status == StatusCancelled {
+			ids = append(ids, result.ID)
+		}
+	}
+
+	return ids
+}
+
+func (r *ReportScheme) count() {
+
+	r.Total = len(r.Results)
+	r.Succeeded, r.Failed, r.Skipped, r.Cancelled, r.Retries = 0, 0, 0, 0, 0
+
+	for _, result := range r.Results {
+
+		switch result.Status {
+		case StatusSucceeded:
+			r.Succeeded++
+		case StatusFailed:
+			r.Failed++
+		case StatusSkipped:
+			r.Skipped++
+		case StatusCancelled:
+			r.Cancelled++
+		}
+
+		if result.Attempts > 1 {
+			r.Retries += result.Attempts - 1
+		}
+	}
+}
+
+// parseAPIError decodes the error collection from the response body, nil when the body isn't an error collection.
+func parseAPIError(response *model.ResponseScheme) *APIErrorScheme {
+
+	if response == nil || response.Bytes.Len() == 0 {
+		return nil
+	}
+
+	apiError := new(APIErrorScheme)
+	if err := json.Unmarshal(response.Bytes.Bytes(), apiError); err != nil {
+		return nil
+	}
+
+	if len(apiError.ErrorMessages) == 0 && len(apiError.Errors) == 0 {
+		return nil
+	}
+
+	return apiError
+}
@@ -1,7 +1,6 @@
 package internal
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -95,6 +94,22 @@ func (i *IssueAttachmentService) Download(ctx context.Context, attachmentID stri
 	return i.internalClient.Download(ctx, attachmentID, redirect)
 }
 
+// stream returns the contents of an attachment without buffering them when the connector supports it,
+// the caller closes the reader.
+func (i *IssueAttachmentService) stream(ctx context.Context, attachmentID string) (io.ReadCloser, *model.ResponseScheme, error) {
+
+	if impl, ok := i.internalClient.(*internalIssueAttachmentServiceImpl); ok {
+		return impl.stream(ctx, attachmentID)
+	}
+
+	response, err := i.internalClient.Download(ctx, attachmentID, true)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return io.NopCloser(&response.Bytes), response, nil
+}
+
 type internalIssueAttachmentServiceImpl struct {
 	c       service.Connector
 	version string
@@ -125,6 +140,40 @@ func (i *internalIssueAttachmentServiceImpl) Download(ctx context.Context, attac
 	return i.c.Call(request, nil)
 }
 
+// stream returns the contents of an attachment with the response body unread, the caller closes it.
+// The contents are buffered when the connector can't stream them.
+func (i *internalIssueAttachmentServiceImpl) stream(ctx context.Context, attachmentID string) (io.ReadCloser, *model.ResponseScheme, error) {
+
+	streamer, ok := i.c.(service.Streamer)
+	if !ok {
+
+		response, err := i.Download(ctx, attachmentID, true)
+		if err != nil {
+			return nil, response, err
+		}
+
+		return io.NopCloser(&response.Bytes), response, nil
+	}
+
+	if attachmentID == "" {
+		return nil, nil, model.ErrNoAttachmentID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/attachment/content/%v", i.version, attachmentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := streamer.Stream(request)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return response.Body, response, nil
+}
+
 func (i *internalIssueAttachmentServiceImpl) Settings(ctx context.Context) (*model.AttachmentSettingScheme, *model.ResponseScheme, error) {
 
 	endpoint := fmt.Sprintf("rest/api/%v/attachment/meta", i.version)
@@ -219,25 +268,31 @@ func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOr
 
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/attachments", i.version, issueKeyOrID)
 
-	reader := &bytes.Buffer{}
-	writer := multipart.NewWriter(reader)
+	reader, pipe := io.Pipe()
+	writer := multipart.NewWriter(pipe)
 
-	attachment, err := writer.CreateFormFile("file", fileName)
-	if err != nil {
-		return nil, nil, err
-	}
+	// The reader is closed once the call returns, so the writer doesn't block when the body wasn't fully read.
+	defer reader.Close()
 
-	_, err = io.Copy(attachment, file)
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), reader)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	writer.Close()
+	// The multipart body is written while the request is sent, so the file isn't buffered.
+	go func() {
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), reader)
-	if err != nil {
-		return nil, nil, err
-	}
+		attachment, err := writer.CreateFormFile("file", fileName)
+		if err == nil {
+			_, err = io.Copy(attachment, file)
+		}
+
+		if err == nil {
+			err = writer.Close()
+		}
+
+		pipe.CloseWithError(err)
+	}()
 
 	var attachments []*model.IssueAttachmentScheme
 	response, err := i.c.Call(request, &attachments)
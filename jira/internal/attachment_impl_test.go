@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -937,6 +938,63 @@ func Test_internalIssueAttachmentServiceImpl_Download(t *testing.T) {
 	}
 }
 
+// streamConnectorMocked is a connector able to stream the response bodies.
+type streamConnectorMocked struct {
+	*mocks.Connector
+	response *model.ResponseScheme
+}
+
+func (s *streamConnectorMocked) Stream(*http.Request) (*model.ResponseScheme, error) {
+	return s.response, nil
+}
+
+func Test_internalIssueAttachmentServiceImpl_stream(t *testing.T) {
+
+	t.Run("when the connector streams the response body", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		client.On("NewRequest", context.Background(), http.MethodGet, "rest/api/3/attachment/content/10001", "", nil).
+			Return(&http.Request{}, nil)
+
+		response := &model.ResponseScheme{Response: &http.Response{Body: io.NopCloser(strings.NewReader("file content"))}}
+		impl := &internalIssueAttachmentServiceImpl{c: &streamConnectorMocked{Connector: client, response: response}, version: "3"}
+
+		content, _, err := impl.stream(context.Background(), "10001")
+		assert.NoError(t, err)
+
+		body, err := io.ReadAll(content)
+		assert.NoError(t, err)
+		assert.Equal(t, "file content", string(body))
+	})
+
+	t.Run("when the connector buffers the response body", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+		client.On("NewRequest", context.Background(), http.MethodGet, "rest/api/3/attachment/content/10001", "", nil).
+			Return(&http.Request{}, nil)
+
+		response := &model.ResponseScheme{}
+		response.Bytes.WriteString("file content")
+		client.On("Call", &http.Request{}, nil).
+			Return(response, nil)
+
+		content, _, err := (&internalIssueAttachmentServiceImpl{c: client, version: "3"}).stream(context.Background(), "10001")
+		assert.NoError(t, err)
+
+		body, err := io.ReadAll(content)
+		assert.NoError(t, err)
+		assert.Equal(t, "file content", string(body))
+	})
+
+	t.Run("when the attachment id is not provided", func(t *testing.T) {
+
+		impl := &internalIssueAttachmentServiceImpl{c: &streamConnectorMocked{}, version: "3"}
+
+		_, _, err := impl.stream(context.Background(), "")
+		assert.ErrorIs(t, err, model.ErrNoAttachmentID)
+	})
+}
+
 func TestNewIssueAttachmentService(t *testing.T) {
 
 	type args struct {
@@ -0,0 +1,248 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewAvatarService creates a new instance of AvatarService.
+func NewAvatarService(client service.Connector, version string) (*AvatarService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &AvatarService{
+		internalClient: &internalAvatarImpl{c: client, version: version},
+	}, nil
+}
+
+// AvatarService provides methods to manage the avatars of the projects, issue types and priorities in Jira.
+type AvatarService struct {
+	// internalClient is the connector interface for avatar operations.
+	internalClient jira.AvatarConnector
+}
+
+// Gets returns the system and custom avatars of a project, issue type or priority.
+//
+// The avatarType is models.AvatarTypeProject, models.AvatarTypeIssueType or models.AvatarTypePriority.
+//
+// GET /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}
+func (a *AvatarService) Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Gets(ctx, avatarType, entityID)
+}
+
+// System returns the system avatars of a type.
+//
+// GET /rest/api/{2-3}/avatar/{type}/system
+func (a *AvatarService) System(ctx context.Context, avatarType string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+	return a.internalClient.System(ctx, avatarType)
+}
+
+// Upload uploads a custom avatar for a project, issue type or priority.
+//
+// The image is streamed to Jira, its content type is detected from its first bytes.
+//
+// The crop selects the square area of the image used as avatar, the whole image is used when nil.
+//
+// POST /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}
+func (a *AvatarService) Upload(ctx context.Context, avatarType, entityID string, image io.Reader, crop *model.AvatarCropScheme) (*model.AvatarScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Upload(ctx, avatarType, entityID, image, crop)
+}
+
+// Set sets the avatar of a project or issue type.
+//
+// The projects are identified by ID or key, the priorities can't be set through this method.
+//
+// PUT /rest/api/{2-3}/project/{projectKeyOrID}/avatar
+//
+// PUT /rest/api/{2-3}/issuetype/{issueTypeID}
+func (a *AvatarService) Set(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error) {
+	return a.internalClient.Set(ctx, avatarType, entityID, avatarID)
+}
+
+// Delete deletes a custom avatar of a project, issue type or priority.
+//
+// DELETE /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}/avatar/{avatarID}
+func (a *AvatarService) Delete(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error) {
+	return a.internalClient.Delete(ctx, avatarType, entityID, avatarID)
+}
+
+type internalAvatarImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalAvatarImpl) Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+
+	if avatarType == "" {
+		return nil, nil, model.ErrNoAvatarType
+	}
+
+	if entityID == "" {
+		return nil, nil, model.ErrNoAvatarOwner
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v", i.version, avatarType, entityID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(model.AvatarsScheme)
+	response, err := i.c.Call(request, avatars)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return avatars, response, nil
+}
+
+func (i *internalAvatarImpl) System(ctx context.Context, avatarType string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+
+	if avatarType == "" {
+		return nil, nil, model.ErrNoAvatarType
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/avatar/%v/system", i.version, avatarType)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(model.AvatarsScheme)
+	response, err := i.c.Call(request, avatars)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return avatars, response, nil
+}
+
+func (i *internalAvatarImpl) Upload(ctx context.Context, avatarType, entityID string, image io.Reader, crop *model.AvatarCropScheme) (*model.AvatarScheme, *model.ResponseScheme, error) {
+
+	if avatarType == "" {
+		return nil, nil, model.ErrNoAvatarType
+	}
+
+	if entityID == "" {
+		return nil, nil, model.ErrNoAvatarOwner
+	}
+
+	if image == nil {
+		return nil, nil, model.ErrNoReader
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v", i.version, avatarType, entityID))
+
+	if crop != nil {
+		params := url.Values{}
+		params.Add("x", strconv.Itoa(crop.X))
+		params.Add("y", strconv.Itoa(crop.Y))
+		params.Add("size", strconv.Itoa(crop.Size))
+
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	// The content type is detected from the first bytes, the image is streamed without being buffered.
+	reader := bufio.NewReader(image)
+
+	head, err := reader.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), http.DetectContentType(head), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatar := new(model.AvatarScheme)
+	response, err := i.c.Call(request, avatar)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return avatar, response, nil
+}
+
+func (i *internalAvatarImpl) Set(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error) {
+
+	if avatarType == "" {
+		return nil, model.ErrNoAvatarType
+	}
+
+	if entityID == "" {
+		return nil, model.ErrNoAvatarOwner
+	}
+
+	if avatarID == "" {
+		return nil, model.ErrNoAvatarID
+	}
+
+	var (
+		endpoint string
+		payload  interface{}
+	)
+
+	switch avatarType {
+	case model.AvatarTypeProject:
+		endpoint = fmt.Sprintf("rest/api/%v/project/%v/avatar", i.version, entityID)
+		payload = &model.AvatarScheme{ID: avatarID}
+	case model.AvatarTypeIssueType:
+
+		id, err := strconv.Atoi(avatarID)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint = fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, entityID)
+		payload = &model.IssueTypePayloadScheme{AvatarID: id}
+	default:
+		return nil, model.ErrInvalidAvatarType
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalAvatarImpl) Delete(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error) {
+
+	if avatarType == "" {
+		return nil, model.ErrNoAvatarType
+	}
+
+	if entityID == "" {
+		return nil, model.ErrNoAvatarOwner
+	}
+
+	if avatarID == "" {
+		return nil, model.ErrNoAvatarID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v/avatar/%v", i.version, avatarType, entityID, avatarID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,1019 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalAvatarImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		avatarType string
+		entityID   string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/universal_avatar/type/project/owner/10000",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/universal_avatar/type/project/owner/10000",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the avatar type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: "",
+				entityID:   "10000",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarType,
+		},
+
+		{
+			name:   "when the avatar owner is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarOwner,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/universal_avatar/type/project/owner/10000",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/universal_avatar/type/project/owner/10000",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed. Please fix me"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			avatarService, err := NewAvatarService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := avatarService.Gets(testCase.args.ctx, testCase.args.avatarType, testCase.args.entityID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAvatarImpl_System(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		avatarType string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/avatar/issuetype/system",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/avatar/issuetype/system",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the avatar type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarType,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/avatar/issuetype/system",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/avatar/issuetype/system",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarsScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed. Please fix me"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			avatarService, err := NewAvatarService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := avatarService.System(testCase.args.ctx, testCase.args.avatarType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAvatarImpl_Upload(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		avatarType string
+		entityID   string
+		image      io.Reader
+		crop       *model.AvatarCropScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/universal_avatar/type/project/owner/10000?size=128&x=10&y=20",
+					"image/png", mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/universal_avatar/type/project/owner/10000?size=128&x=10&y=20",
+					"image/png", mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the avatar type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: "",
+				entityID:   "10000",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarType,
+		},
+
+		{
+			name:   "when the avatar owner is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarOwner,
+		},
+
+		{
+			name:   "when the image reader is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				image:      nil,
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			wantErr: true,
+			Err:     model.ErrNoReader,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/universal_avatar/type/project/owner/10000?size=128&x=10&y=20",
+					"image/png", mock.Anything).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				image:      bytes.NewReader([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")),
+				crop:       &model.AvatarCropScheme{X: 10, Y: 20, Size: 128},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/universal_avatar/type/project/owner/10000?size=128&x=10&y=20",
+					"image/png", mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AvatarScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed. Please fix me"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			avatarService, err := NewAvatarService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := avatarService.Upload(testCase.args.ctx, testCase.args.avatarType, testCase.args.entityID, testCase.args.image, testCase.args.crop)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAvatarImpl_Set(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		avatarType string
+		entityID   string
+		avatarID   string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+				entityID:   "10001",
+				avatarID:   "10300",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuetype/10001",
+					"", &model.IssueTypePayloadScheme{AvatarID: 10300}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+				entityID:   "10001",
+				avatarID:   "10300",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuetype/10001",
+					"", &model.IssueTypePayloadScheme{AvatarID: 10300}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the avatar type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: "",
+				entityID:   "10001",
+				avatarID:   "10300",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarType,
+		},
+
+		{
+			name:   "when the avatar owner is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+				entityID:   "",
+				avatarID:   "10300",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarOwner,
+		},
+
+		{
+			name:   "when the avatar id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+				entityID:   "10001",
+				avatarID:   "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeIssueType,
+				entityID:   "10001",
+				avatarID:   "10300",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuetype/10001",
+					"", &model.IssueTypePayloadScheme{AvatarID: 10300}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			avatarService, err := NewAvatarService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := avatarService.Set(testCase.args.ctx, testCase.args.avatarType, testCase.args.entityID, testCase.args.avatarID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAvatarImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx        context.Context
+		avatarType string
+		entityID   string
+		avatarID   string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				avatarID:   "10400",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/universal_avatar/type/project/owner/10000/avatar/10400",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				avatarID:   "10400",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/universal_avatar/type/project/owner/10000/avatar/10400",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the avatar type is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: "",
+				entityID:   "10000",
+				avatarID:   "10400",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarType,
+		},
+
+		{
+			name:   "when the avatar owner is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "",
+				avatarID:   "10400",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarOwner,
+		},
+
+		{
+			name:   "when the avatar id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				avatarID:   "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAvatarID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:        context.Background(),
+				avatarType: model.AvatarTypeProject,
+				entityID:   "10000",
+				avatarID:   "10400",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/universal_avatar/type/project/owner/10000/avatar/10400",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			avatarService, err := NewAvatarService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := avatarService.Delete(testCase.args.ctx, testCase.args.avatarType, testCase.args.entityID, testCase.args.avatarID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_NewAvatarService(t *testing.T) {
+
+	type args struct {
+		client  service.Connector
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				client:  nil,
+				version: "3",
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the version is not provided",
+			args: args{
+				client:  nil,
+				version: "",
+			},
+			wantErr: true,
+			err:     model.ErrNoVersionProvided,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := NewAvatarService(testCase.args.client, testCase.args.version)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, got, nil)
+			}
+		})
+	}
+}
+
+func Test_internalAvatarImpl_Set_Project(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodPut,
+		"rest/api/3/project/KP/avatar",
+		"", &model.AvatarScheme{ID: "10400"}).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		nil).
+		Return(&model.ResponseScheme{}, nil)
+
+	avatarService, err := NewAvatarService(client, "3")
+	assert.NoError(t, err)
+
+	_, err = avatarService.Set(context.Background(), model.AvatarTypeProject, "KP", "10400")
+	assert.NoError(t, err)
+
+	_, err = avatarService.Set(context.Background(), model.AvatarTypePriority, "1", "10400")
+	assert.ErrorIs(t, err, model.ErrInvalidAvatarType)
+}
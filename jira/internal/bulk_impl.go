@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewBulkService creates a new instance of BulkService.
+func NewBulkService(client service.Connector, version string) (*BulkService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &BulkService{
+		internalClient: &internalBulkServiceImpl{c: client, version: version},
+	}, nil
+}
+
+// BulkService provides methods to edit, move, transition, delete and watch issues in bulk.
+type BulkService struct {
+	// internalClient is the connector interface for bulk operations.
+	internalClient jira.BulkConnector
+}
+
+// Fields returns the fields that can be edited in bulk on the issues provided.
+//
+// GET /rest/api/{2-3}/bulk/issues/fields
+func (b *BulkService) Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Fields(ctx, issueIDsOrKeys, searchText, startingAfter, endingBefore)
+}
+
+// Edit edits the fields of up to 1000 issues, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/fields
+func (b *BulkService) Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Edit(ctx, payload)
+}
+
+// Move moves up to 1000 issues to other projects or issue types, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/move
+func (b *BulkService) Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Move(ctx, payload)
+}
+
+// Transitions returns the transitions available for the issues provided, grouped by workflow.
+//
+// GET /rest/api/{2-3}/bulk/issues/transition
+func (b *BulkService) Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Transitions(ctx, issueIDsOrKeys, startingAfter, endingBefore)
+}
+
+// Transition transitions up to 1000 issues, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/transition
+func (b *BulkService) Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Transition(ctx, payload)
+}
+
+// Delete deletes up to 1000 issues, including their subtasks, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/delete
+func (b *BulkService) Delete(ctx context.Context, payload *model.BulkDeletePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, payload)
+}
+
+// Watch adds the user as a watcher of up to 1000 issues, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/watch
+func (b *BulkService) Watch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Watch(ctx, issueIDsOrKeys)
+}
+
+// Unwatch removes the user from the watchers of up to 1000 issues, the operation runs asynchronously.
+//
+// POST /rest/api/{2-3}/bulk/issues/unwatch
+func (b *BulkService) Unwatch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Unwatch(ctx, issueIDsOrKeys)
+}
+
+// Progress returns the progress of a bulk operation, including the issues processed and the failed ones.
+//
+// GET /rest/api/{2-3}/bulk/queue/{taskID}
+func (b *BulkService) Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Progress(ctx, taskID)
+}
+
+// Wait polls the progress of a bulk operation until the task reaches a final status, e.g. COMPLETE or FAILED.
+//
+// The interval is the time between two progress requests, 5 seconds when zero. Use the context to set a deadline,
+// the last progress fetched is returned along with the context error when it's done.
+func (b *BulkService) Wait(ctx context.Context, taskID string, interval time.Duration) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+
+	return waitTask(ctx, interval, func(ctx context.Context) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+		return b.internalClient.Progress(ctx, taskID)
+	})
+}
+
+type internalBulkServiceImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalBulkServiceImpl) Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	params := url.Values{}
+	params.Add("issueIdsOrKeys", strings.Join(issueIDsOrKeys, ","))
+
+	if searchText != "" {
+		params.Add("searchText", searchText)
+	}
+
+	if startingAfter != "" {
+		params.Add("startingAfter", startingAfter)
+	}
+
+	if endingBefore != "" {
+		params.Add("endingBefore", endingBefore)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/issues/fields?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := new(model.BulkEditableFieldPageScheme)
+	response, err := i.c.Call(request, fields)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return fields, response, nil
+}
+
+func (i *internalBulkServiceImpl) Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.SelectedIssueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	return i.submit(ctx, "bulk/issues/fields", payload)
+}
+
+func (i *internalBulkServiceImpl) Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.TargetToSourcesMapping) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	return i.submit(ctx, "bulk/issues/move", payload)
+}
+
+func (i *internalBulkServiceImpl) Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	params := url.Values{}
+	params.Add("issueIdsOrKeys", strings.Join(issueIDsOrKeys, ","))
+
+	if startingAfter != "" {
+		params.Add("startingAfter", startingAfter)
+	}
+
+	if endingBefore != "" {
+		params.Add("endingBefore", endingBefore)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/issues/transition?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transitions := new(model.BulkTransitionPageScheme)
+	response, err := i.c.Call(request, transitions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return transitions, response, nil
+}
+
+func (i *internalBulkServiceImpl) Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.BulkTransitionInputs) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	for _, input := range payload.BulkTransitionInputs {
+		if input.TransitionID == "" {
+			return nil, nil, model.ErrNoTransitionID
+		}
+	}
+
+	return i.submit(ctx, "bulk/issues/transition", payload)
+}
+
+func (i *internalBulkServiceImpl) Delete(ctx context.Context, payload *model.BulkDeletePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.SelectedIssueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	return i.submit(ctx, "bulk/issues/delete", payload)
+}
+
+func (i *internalBulkServiceImpl) Watch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	return i.submit(ctx, "bulk/issues/watch", map[string]interface{}{"selectedIssueIdsOrKeys": issueIDsOrKeys})
+}
+
+func (i *internalBulkServiceImpl) Unwatch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	return i.submit(ctx, "bulk/issues/unwatch", map[string]interface{}{"selectedIssueIdsOrKeys": issueIDsOrKeys})
+}
+
+func (i *internalBulkServiceImpl) Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+
+	if taskID == "" {
+		return nil, nil, model.ErrNoTaskID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/queue/%v", i.version, taskID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(model.BulkOperationProgressScheme)
+	response, err := i.c.Call(request, progress)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return progress, response, nil
+}
+
+// submit sends a bulk operation, returning the task used to track its progress.
+func (i *internalBulkServiceImpl) submit(ctx context.Context, path string, payload interface{}) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/%v", i.version, path)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.BulkTaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
@@ -0,0 +1,417 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalBulkServiceImpl_Fields(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                                     context.Context
+		issueIDsOrKeys                          []string
+		searchText, startingAfter, endingBefore string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: []string{"KP-1", "KP-2"},
+				searchText:     "sum",
+				startingAfter:  "cursor-1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/bulk/issues/fields?issueIdsOrKeys=KP-1%2CKP-2&searchText=sum&startingAfter=cursor-1",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkEditableFieldPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: []string{"KP-1"},
+				endingBefore:   "cursor-2",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/bulk/issues/fields?endingBefore=cursor-2&issueIdsOrKeys=KP-1",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkEditableFieldPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeysOrIDs,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: []string{"KP-1"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/bulk/issues/fields?issueIdsOrKeys=KP-1",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			bulkService, err := NewBulkService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := bulkService.Fields(testCase.args.ctx, testCase.args.issueIDsOrKeys,
+				testCase.args.searchText, testCase.args.startingAfter, testCase.args.endingBefore)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalBulkServiceImpl_Operations(t *testing.T) {
+
+	notify := false
+
+	editPayload := &model.BulkEditPayloadScheme{
+		SelectedIssueIDsOrKeys: []string{"KP-1"},
+		SelectedActions:        []string{"priority"},
+		EditedFieldsInput:      map[string]interface{}{"priority": map[string]interface{}{"priorityId": "1"}},
+		SendBulkNotification:   &notify,
+	}
+
+	movePayload := &model.BulkMovePayloadScheme{
+		TargetToSourcesMapping: map[string]*model.BulkMoveTargetScheme{
+			"KP2,10001": {IssueIDsOrKeys: []string{"KP-1"}, InferFieldDefaults: true, InferStatusDefaults: true},
+		},
+	}
+
+	transitionPayload := &model.BulkTransitionPayloadScheme{
+		BulkTransitionInputs: []*model.BulkTransitionInputScheme{{SelectedIssueIDsOrKeys: []string{"KP-1"}, TransitionID: "11"}},
+	}
+
+	deletePayload := &model.BulkDeletePayloadScheme{SelectedIssueIDsOrKeys: []string{"KP-1"}}
+	watchPayload := map[string]interface{}{"selectedIssueIdsOrKeys": []string{"KP-1"}}
+
+	testCases := []struct {
+		name     string
+		endpoint string
+		payload  interface{}
+		call     func(*BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name:     "when the issues are edited",
+			endpoint: "rest/api/3/bulk/issues/fields",
+			payload:  editPayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Edit(context.Background(), editPayload)
+			},
+		},
+		{
+			name:     "when the issues are moved",
+			endpoint: "rest/api/3/bulk/issues/move",
+			payload:  movePayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Move(context.Background(), movePayload)
+			},
+		},
+		{
+			name:     "when the issues are transitioned",
+			endpoint: "rest/api/3/bulk/issues/transition",
+			payload:  transitionPayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Transition(context.Background(), transitionPayload)
+			},
+		},
+		{
+			name:     "when the issues are deleted",
+			endpoint: "rest/api/3/bulk/issues/delete",
+			payload:  deletePayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Delete(context.Background(), deletePayload)
+			},
+		},
+		{
+			name:     "when the issues are watched",
+			endpoint: "rest/api/3/bulk/issues/watch",
+			payload:  watchPayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Watch(context.Background(), []string{"KP-1"})
+			},
+		},
+		{
+			name:     "when the issues are unwatched",
+			endpoint: "rest/api/3/bulk/issues/unwatch",
+			payload:  watchPayload,
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Unwatch(context.Background(), []string{"KP-1"})
+			},
+		},
+		{
+			name: "when the edit payload is not provided",
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Edit(context.Background(), nil)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeysOrIDs,
+		},
+		{
+			name: "when the transition id is not provided",
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Transition(context.Background(), &model.BulkTransitionPayloadScheme{
+					BulkTransitionInputs: []*model.BulkTransitionInputScheme{{SelectedIssueIDsOrKeys: []string{"KP-1"}}},
+				})
+			},
+			wantErr: true,
+			Err:     model.ErrNoTransitionID,
+		},
+		{
+			name: "when the watched issues are not provided",
+			call: func(s *BulkService) (*model.BulkTaskScheme, *model.ResponseScheme, error) {
+				return s.Watch(context.Background(), nil)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeysOrIDs,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			if !testCase.wantErr {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					testCase.endpoint,
+					"",
+					testCase.payload).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkTaskScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+			}
+
+			bulkService, err := NewBulkService(client, "3")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := testCase.call(bulkService)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.NotEqual(t, gotResult, nil)
+		})
+	}
+}
+
+func Test_internalBulkServiceImpl_Transitions(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"rest/api/2/bulk/issues/transition?issueIdsOrKeys=KP-1%2CKP-2&startingAfter=cursor-1",
+		"",
+		nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		&model.BulkTransitionPageScheme{}).
+		Return(&model.ResponseScheme{}, nil)
+
+	bulkService, err := NewBulkService(client, "2")
+	assert.NoError(t, err)
+
+	_, _, err = bulkService.Transitions(context.Background(), []string{"KP-1", "KP-2"}, "cursor-1", "")
+	assert.NoError(t, err)
+
+	_, _, err = bulkService.Transitions(context.Background(), nil, "", "")
+	assert.ErrorIs(t, err, model.ErrNoIssueKeysOrIDs)
+}
+
+func TestBulkService_Wait(t *testing.T) {
+
+	t.Run("when the task finishes", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", context.Background(), http.MethodGet, "rest/api/3/bulk/queue/10000", "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.BulkOperationProgressScheme).Status = model.BulkTaskStatusRunning
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.BulkOperationProgressScheme) = model.BulkOperationProgressScheme{
+					Status:                    model.BulkTaskStatusComplete,
+					ProcessedAccessibleIssues: []int{10001},
+					FailedAccessibleIssues:    map[string][]string{"10002": {"The issue is locked."}},
+				}
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+
+		bulkService, err := NewBulkService(client, "3")
+		assert.NoError(t, err)
+
+		progress, _, err := bulkService.Wait(context.Background(), "10000", time.Millisecond)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []*model.BulkIssueResultScheme{
+			{IssueID: "10001", Success: true},
+			{IssueID: "10002", Errors: []string{"The issue is locked."}},
+		}, progress.Results())
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", ctx, http.MethodGet, "rest/api/3/bulk/queue/10000", "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.BulkOperationProgressScheme).Status = model.BulkTaskStatusEnqueued
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		bulkService, err := NewBulkService(client, "3")
+		assert.NoError(t, err)
+
+		progress, _, err := bulkService.Wait(ctx, "10000", time.Hour)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, model.BulkTaskStatusEnqueued, progress.Status)
+	})
+
+	t.Run("when the task id is not provided", func(t *testing.T) {
+
+		bulkService, err := NewBulkService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = bulkService.Wait(context.Background(), "", 0)
+		assert.ErrorIs(t, err, model.ErrNoTaskID)
+	})
+}
+
+func Test_NewBulkService(t *testing.T) {
+
+	_, err := NewBulkService(nil, "")
+	assert.ErrorIs(t, err, model.ErrNoVersionProvided)
+
+	got, err := NewBulkService(nil, "3")
+	assert.NoError(t, err)
+	assert.NotNil(t, got)
+}
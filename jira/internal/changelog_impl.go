@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewChangelogService creates a new instance of ChangelogService.
+func NewChangelogService(client service.Connector, version string) (*ChangelogService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &ChangelogService{
+		internalClient: &internalChangelogImpl{c: client, version: version},
+	}, nil
+}
+
+// ChangelogService provides methods to fetch the changelogs of the issues.
+type ChangelogService struct {
+	// internalClient is the connector interface for changelog operations.
+	internalClient jira.ChangelogConnector
+}
+
+// Gets returns a paginated list of all changelogs for an issue sorted by date, starting from the oldest.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+func (c *ChangelogService) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, issueKeyOrID, startAt, maxResults)
+}
+
+// List returns changelogs for an issue specified by a list of changelog IDs.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+func (c *ChangelogService) List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.List(ctx, issueKeyOrID, changelogIDs)
+}
+
+// BulkFetch returns a paginated list of the changelogs of several issues, optionally filtered by fields.
+//
+// POST /rest/api/{2-3}/changelog/bulkfetch
+func (c *ChangelogService) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchScheme, *model.ResponseScheme, error) {
+	return c.internalClient.BulkFetch(ctx, payload)
+}
+
+// All returns every changelog of an issue, fetching the pages until the last one.
+//
+// The histories are not truncated, unlike the changelog returned using the "changelog" expand on the issue.
+// The maxResults parameter is the page size used on each request, the response returned is the last one.
+func (c *ChangelogService) All(ctx context.Context, issueKeyOrID string, maxResults int) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+
+	var (
+		histories []*model.IssueChangelogHistoryScheme
+		startAt   int
+	)
+
+	for {
+
+		page, response, err := c.internalClient.Gets(ctx, issueKeyOrID, startAt, maxResults)
+		if err != nil {
+			return nil, response, err
+		}
+
+		histories = append(histories, page.Values...)
+		startAt += len(page.Values)
+
+		if page.IsLast || len(page.Values) == 0 || (page.Total != 0 && startAt >= page.Total) {
+			return histories, response, nil
+		}
+	}
+}
+
+// BulkFetchAll returns the changelogs of several issues, fetching the pages until the last one.
+//
+// The histories of the same issue returned on different pages are merged, the issues are returned in the
+// order they're returned by Jira.
+func (c *ChangelogService) BulkFetchAll(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) ([]*model.IssueChangelogBulkFetchItemScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	pagePayload := *payload
+
+	var (
+		changelogs []*model.IssueChangelogBulkFetchItemScheme
+		byIssue    = make(map[string]*model.IssueChangelogBulkFetchItemScheme)
+	)
+
+	for {
+
+		page, response, err := c.internalClient.BulkFetch(ctx, &pagePayload)
+		if err != nil {
+			return nil, response, err
+		}
+
+		for _, changelog := range page.IssueChangeLogs {
+
+			if merged, ok := byIssue[changelog.IssueID]; ok {
+				merged.ChangeHistories = append(merged.ChangeHistories, changelog.ChangeHistories...)
+				continue
+			}
+
+			byIssue[changelog.IssueID] = changelog
+			changelogs = append(changelogs, changelog)
+		}
+
+		if page.NextPageToken == "" || page.NextPageToken == pagePayload.NextPageToken {
+			return changelogs, response, nil
+		}
+
+		pagePayload.NextPageToken = page.NextPageToken
+	}
+}
+
+type internalChangelogImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalChangelogImpl) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog?%v", i.version, issueKeyOrID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelogs := new(model.IssueChangelogPageScheme)
+	response, err := i.c.Call(request, changelogs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelogs, response, nil
+}
+
+func (i *internalChangelogImpl) List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	if len(changelogIDs) == 0 {
+		return nil, nil, model.ErrNoChangelogIDs
+	}
+
+	payload := map[string]interface{}{"changelogIds": changelogIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog/list", i.version, issueKeyOrID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelogs := new(model.IssueChangelogPageScheme)
+	response, err := i.c.Call(request, changelogs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelogs, response, nil
+}
+
+func (i *internalChangelogImpl) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.IssueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/changelog/bulkfetch", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelogs := new(model.IssueChangelogBulkFetchScheme)
+	response, err := i.c.Call(request, changelogs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelogs, response, nil
+}
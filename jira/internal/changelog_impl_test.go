@@ -0,0 +1,553 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalChangelogImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                 context.Context
+		issueKeyOrID        string
+		startAt, maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				startAt:      0,
+				maxResults:   100,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/changelog?maxResults=100&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				startAt:      100,
+				maxResults:   50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1/changelog?maxResults=50&startAt=100",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				maxResults:   100,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/changelog?maxResults=100&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			changelogService, err := NewChangelogService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := changelogService.Gets(testCase.args.ctx, testCase.args.issueKeyOrID,
+				testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalChangelogImpl_List(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		changelogIDs []int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				changelogIDs: []int{10001, 10002},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/KP-1/changelog/list",
+					"",
+					map[string]interface{}{"changelogIds": []int{10001, 10002}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				changelogIDs: []int{10001},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/KP-1/changelog/list",
+					"",
+					map[string]interface{}{"changelogIds": []int{10001}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				changelogIDs: []int{10001},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the changelog ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+			},
+			wantErr: true,
+			Err:     model.ErrNoChangelogIDs,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			changelogService, err := NewChangelogService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := changelogService.List(testCase.args.ctx, testCase.args.issueKeyOrID,
+				testCase.args.changelogIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalChangelogImpl_BulkFetch(t *testing.T) {
+
+	payloadMocked := &model.IssueChangelogBulkFetchPayloadScheme{
+		IssueIDsOrKeys: []string{"KP-1", "KP-2"},
+		FieldIDs:       []string{"status"},
+		MaxResults:     1000,
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueChangelogBulkFetchPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/changelog/bulkfetch",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogBulkFetchScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/changelog/bulkfetch",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogBulkFetchScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueChangelogBulkFetchPayloadScheme{FieldIDs: []string{"status"}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeysOrIDs,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/changelog/bulkfetch",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			changelogService, err := NewChangelogService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := changelogService.BulkFetch(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func TestChangelogService_All(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	pages := map[string]*model.IssueChangelogPageScheme{
+		"rest/api/3/issue/KP-1/changelog?maxResults=2&startAt=0": {
+			Total:  3,
+			Values: []*model.IssueChangelogHistoryScheme{{ID: "1"}, {ID: "2"}},
+		},
+		"rest/api/3/issue/KP-1/changelog?maxResults=2&startAt=2": {
+			Total:  3,
+			IsLast: true,
+			Values: []*model.IssueChangelogHistoryScheme{{ID: "3"}},
+		},
+	}
+
+	for endpoint, page := range pages {
+
+		request, _ := http.NewRequest(http.MethodGet, endpoint, nil)
+		client.On("NewRequest", context.Background(), http.MethodGet, endpoint, "", nil).
+			Return(request, nil)
+
+		page := page
+		client.On("Call", request, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.IssueChangelogPageScheme) = *page
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	changelogService, err := NewChangelogService(client, "3")
+	assert.NoError(t, err)
+
+	histories, response, err := changelogService.All(context.Background(), "KP-1", 2)
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, []*model.IssueChangelogHistoryScheme{{ID: "1"}, {ID: "2"}, {ID: "3"}}, histories)
+
+	_, _, err = changelogService.All(context.Background(), "", 2)
+	assert.ErrorIs(t, err, model.ErrNoIssueKeyOrID)
+}
+
+func TestChangelogService_BulkFetchAll(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	firstPayload := &model.IssueChangelogBulkFetchPayloadScheme{IssueIDsOrKeys: []string{"KP-1", "KP-2"}, MaxResults: 2}
+	secondPayload := &model.IssueChangelogBulkFetchPayloadScheme{IssueIDsOrKeys: []string{"KP-1", "KP-2"}, MaxResults: 2,
+		NextPageToken: "token-2"}
+
+	firstRequest, _ := http.NewRequest(http.MethodPost, "first", nil)
+	secondRequest, _ := http.NewRequest(http.MethodPost, "second", nil)
+
+	client.On("NewRequest", context.Background(), http.MethodPost, "rest/api/3/changelog/bulkfetch", "", firstPayload).
+		Return(firstRequest, nil).Once()
+
+	client.On("NewRequest", context.Background(), http.MethodPost, "rest/api/3/changelog/bulkfetch", "", secondPayload).
+		Return(secondRequest, nil).Once()
+
+	client.On("Call", firstRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.IssueChangelogBulkFetchScheme) = model.IssueChangelogBulkFetchScheme{
+				IssueChangeLogs: []*model.IssueChangelogBulkFetchItemScheme{
+					{IssueID: "10001", ChangeHistories: []*model.IssueChangelogHistoryScheme{{ID: "1"}, {ID: "2"}}},
+				},
+				NextPageToken: "token-2",
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("Call", secondRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.IssueChangelogBulkFetchScheme) = model.IssueChangelogBulkFetchScheme{
+				IssueChangeLogs: []*model.IssueChangelogBulkFetchItemScheme{
+					{IssueID: "10001", ChangeHistories: []*model.IssueChangelogHistoryScheme{{ID: "3"}}},
+					{IssueID: "10002", ChangeHistories: []*model.IssueChangelogHistoryScheme{{ID: "4"}}},
+				},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	changelogService, err := NewChangelogService(client, "3")
+	assert.NoError(t, err)
+
+	changelogs, _, err := changelogService.BulkFetchAll(context.Background(),
+		&model.IssueChangelogBulkFetchPayloadScheme{IssueIDsOrKeys: []string{"KP-1", "KP-2"}, MaxResults: 2})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*model.IssueChangelogBulkFetchItemScheme{
+		{IssueID: "10001", ChangeHistories: []*model.IssueChangelogHistoryScheme{{ID: "1"}, {ID: "2"}, {ID: "3"}}},
+		{IssueID: "10002", ChangeHistories: []*model.IssueChangelogHistoryScheme{{ID: "4"}}},
+	}, changelogs)
+
+	_, _, err = changelogService.BulkFetchAll(context.Background(), nil)
+	assert.ErrorIs(t, err, model.ErrNoIssueKeysOrIDs)
+}
+
+func Test_NewChangelogService(t *testing.T) {
+
+	_, err := NewChangelogService(nil, "")
+	assert.ErrorIs(t, err, model.ErrNoVersionProvided)
+
+	got, err := NewChangelogService(nil, "3")
+	assert.NoError(t, err)
+	assert.NotNil(t, got)
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewDashboardGadgetService creates a new instance of DashboardGadgetService.
+func NewDashboardGadgetService(client service.Connector, version string) (*DashboardGadgetService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &DashboardGadgetService{
+		internalClient: &internalDashboardGadgetImpl{c: client, version: version},
+	}, nil
+}
+
+// DashboardGadgetService provides methods to manage the gadgets of the Jira dashboards.
+type DashboardGadgetService struct {
+	// internalClient is the connector interface for dashboard gadget operations.
+	internalClient jira.DashboardGadgetConnector
+}
+
+// Gets returns the gadgets of a dashboard, optionally filtered by gadget type or ID.
+//
+// GET /rest/api/{2-3}/dashboard/{dashboardID}/gadget
+func (d *DashboardGadgetService) Gets(ctx context.Context, dashboardID string, options *model.DashboardGadgetSearchOptionsScheme) (*model.DashboardGadgetPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Gets(ctx, dashboardID, options)
+}
+
+// Add adds a gadget to a dashboard, the gadget type is identified by its module key or URI.
+//
+// POST /rest/api/{2-3}/dashboard/{dashboardID}/gadget
+func (d *DashboardGadgetService) Add(ctx context.Context, dashboardID string, payload *model.DashboardGadgetPayloadScheme) (*model.DashboardGadgetScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Add(ctx, dashboardID, payload)
+}
+
+// Update changes the title, color or position of a gadget.
+//
+// PUT /rest/api/{2-3}/dashboard/{dashboardID}/gadget/{gadgetID}
+func (d *DashboardGadgetService) Update(ctx context.Context, dashboardID string, gadgetID int, payload *model.DashboardGadgetPayloadScheme) (*model.ResponseScheme, error) {
+	return d.internalClient.Update(ctx, dashboardID, gadgetID, payload)
+}
+
+// Remove removes a gadget from a dashboard, the gadgets below it move up.
+//
+// DELETE /rest/api/{2-3}/dashboard/{dashboardID}/gadget/{gadgetID}
+func (d *DashboardGadgetService) Remove(ctx context.Context, dashboardID string, gadgetID int) (*model.ResponseScheme, error) {
+	return d.internalClient.Remove(ctx, dashboardID, gadgetID)
+}
+
+// Available returns the gadgets that can be added to the dashboards.
+//
+// GET /rest/api/{2-3}/dashboard/gadgets
+func (d *DashboardGadgetService) Available(ctx context.Context) (*model.AvailableDashboardGadgetPageScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Available(ctx)
+}
+
+type internalDashboardGadgetImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalDashboardGadgetImpl) Gets(ctx context.Context, dashboardID string, options *model.DashboardGadgetSearchOptionsScheme) (*model.DashboardGadgetPageScheme, *model.ResponseScheme, error) {
+
+	if dashboardID == "" {
+		return nil, nil, model.ErrNoDashboardID
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/dashboard/%v/gadget", i.version, dashboardID))
+
+	if options != nil {
+
+		params := url.Values{}
+		for _, moduleKey := range options.ModuleKeys {
+			params.Add("moduleKey", moduleKey)
+		}
+
+		for _, uri := range options.URIs {
+			params.Add("uri", uri)
+		}
+
+		for _, gadgetID := range options.GadgetIDs {
+			params.Add("gadgetId", strconv.Itoa(gadgetID))
+		}
+
+		if len(params) != 0 {
+			endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+		}
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gadgets := new(model.DashboardGadgetPageScheme)
+	response, err := i.c.Call(request, gadgets)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return gadgets, response, nil
+}
+
+func (i *internalDashboardGadgetImpl) Add(ctx context.Context, dashboardID string, payload *model.DashboardGadgetPayloadScheme) (*model.DashboardGadgetScheme, *model.ResponseScheme, error) {
+
+	if dashboardID == "" {
+		return nil, nil, model.ErrNoDashboardID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v/gadget", i.version, dashboardID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gadget := new(model.DashboardGadgetScheme)
+	response, err := i.c.Call(request, gadget)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return gadget, response, nil
+}
+
+func (i *internalDashboardGadgetImpl) Update(ctx context.Context, dashboardID string, gadgetID int, payload *model.DashboardGadgetPayloadScheme) (*model.ResponseScheme, error) {
+
+	if dashboardID == "" {
+		return nil, model.ErrNoDashboardID
+	}
+
+	if gadgetID == 0 {
+		return nil, model.ErrNoGadgetID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v/gadget/%v", i.version, dashboardID, gadgetID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalDashboardGadgetImpl) Remove(ctx context.Context, dashboardID string, gadgetID int) (*model.ResponseScheme, error) {
+
+	if dashboardID == "" {
+		return nil, model.ErrNoDashboardID
+	}
+
+	if gadgetID == 0 {
+		return nil, model.ErrNoGadgetID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v/gadget/%v", i.version, dashboardID, gadgetID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalDashboardGadgetImpl) Available(ctx context.Context) (*model.AvailableDashboardGadgetPageScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/dashboard/gadgets", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gadgets := new(model.AvailableDashboardGadgetPageScheme)
+	response, err := i.c.Call(request, gadgets)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return gadgets, response, nil
+}
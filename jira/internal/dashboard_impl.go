@@ -14,9 +14,9 @@ import (
 )
 
 // NewDashboardService creates a new instance of DashboardService.
-// It takes a service.Connector and a version string as input.
+// It takes a service.Connector, a version string and the gadget service as input.
 // Returns a pointer to DashboardService and an error if the version is not provided.
-func NewDashboardService(client service.Connector, version string) (*DashboardService, error) {
+func NewDashboardService(client service.Connector, version string, gadget *DashboardGadgetService) (*DashboardService, error) {
 
 	if version == "" {
 		return nil, model.ErrNoVersionProvided
@@ -24,6 +24,7 @@ func NewDashboardService(client service.Connector, version string) (*DashboardSe
 
 	return &DashboardService{
 		internalClient: &internalDashboardImpl{c: client, version: version},
+		Gadget:         gadget,
 	}, nil
 }
 
@@ -31,6 +32,8 @@ func NewDashboardService(client service.Connector, version string) (*DashboardSe
 type DashboardService struct {
 	// internalClient is the connector interface for dashboard operations.
 	internalClient jira.DashboardConnector
+	// Gadget is the service for managing the gadgets of the dashboards.
+	Gadget *DashboardGadgetService
 }
 
 // Gets returns a list of dashboards owned by or shared with the user.
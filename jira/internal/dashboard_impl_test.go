@@ -158,7 +158,7 @@ func TestDashboardService_Copy(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Copy(testCase.args.ctx, testCase.args.dashboardID, testCase.args.payload)
@@ -327,7 +327,7 @@ func TestDashboardService_Update(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Update(testCase.args.ctx, testCase.args.dashboardID, testCase.args.payload)
@@ -467,7 +467,7 @@ func TestDashboardService_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Gets(testCase.args.ctx, testCase.args.startAt, testCase.args.startAt, testCase.args.filter)
@@ -617,7 +617,7 @@ func TestDashboardService_Create(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Create(testCase.args.ctx, testCase.args.payload)
@@ -816,7 +816,7 @@ func TestDashboardService_Search(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Search(testCase.args.ctx, testCase.args.options, testCase.args.startAt, testCase.args.maxResults)
@@ -965,7 +965,7 @@ func TestDashboardService_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := applicationService.Get(testCase.args.ctx, testCase.args.dashboardID)
@@ -1114,7 +1114,7 @@ func TestDashboardService_Delete(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version)
+			applicationService, err := NewDashboardService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResponse, err := applicationService.Delete(testCase.args.ctx, testCase.args.dashboardID)
@@ -1171,7 +1171,7 @@ func TestNewDashboardService(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got, err := NewDashboardService(testCase.args.client, testCase.args.version)
+			got, err := NewDashboardService(testCase.args.client, testCase.args.version, nil)
 
 			if testCase.wantErr {
 
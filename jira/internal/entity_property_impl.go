@@ -0,0 +1,252 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewEntityPropertyService creates a new instance of EntityPropertyService.
+func NewEntityPropertyService(client service.Connector, version string) (*EntityPropertyService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &EntityPropertyService{
+		internalClient: &internalEntityPropertyImpl{c: client, version: version},
+	}, nil
+}
+
+// EntityPropertyService provides methods to manage the properties of the Jira entities.
+type EntityPropertyService struct {
+	// internalClient is the connector interface for entity property operations.
+	internalClient jira.EntityPropertyConnector
+}
+
+// Gets returns the keys of the properties of an entity.
+//
+// GET /rest/api/{2-3}/{entity}/properties
+func (e *EntityPropertyService) Gets(ctx context.Context, entity *model.PropertyEntityScheme) (*model.PropertyPageScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Gets(ctx, entity)
+}
+
+// Get returns the key and value of a property of an entity.
+//
+// Decode the value with models.EntityPropertyValue, e.g. models.EntityPropertyValue[*State](property).
+//
+// GET /rest/api/{2-3}/{entity}/properties/{propertyKey}
+func (e *EntityPropertyService) Get(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Get(ctx, entity, propertyKey)
+}
+
+// Set sets the value of a property of an entity, the value must be a valid JSON of up to 32768 characters.
+//
+// PUT /rest/api/{2-3}/{entity}/properties/{propertyKey}
+func (e *EntityPropertyService) Set(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+	return e.internalClient.Set(ctx, entity, propertyKey, value)
+}
+
+// Delete deletes a property of an entity.
+//
+// DELETE /rest/api/{2-3}/{entity}/properties/{propertyKey}
+func (e *EntityPropertyService) Delete(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.ResponseScheme, error) {
+	return e.internalClient.Delete(ctx, entity, propertyKey)
+}
+
+// BulkSet sets the value of a property on the issues matching the filter.
+//
+// The property is updated asynchronously.
+//
+// PUT /rest/api/{2-3}/issue/properties/{propertyKey}
+func (e *EntityPropertyService) BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkSetPayloadScheme) (*model.ResponseScheme, error) {
+	return e.internalClient.BulkSet(ctx, propertyKey, payload)
+}
+
+// BulkDelete deletes a property from the issues matching the filter.
+//
+// The property is deleted asynchronously.
+//
+// DELETE /rest/api/{2-3}/issue/properties/{propertyKey}
+func (e *EntityPropertyService) BulkDelete(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkDeletePayloadScheme) (*model.ResponseScheme, error) {
+	return e.internalClient.BulkDelete(ctx, propertyKey, payload)
+}
+
+type internalEntityPropertyImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalEntityPropertyImpl) Gets(ctx context.Context, entity *model.PropertyEntityScheme) (*model.PropertyPageScheme, *model.ResponseScheme, error) {
+
+	endpoint, err := i.endpoint(entity, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	properties := new(model.PropertyPageScheme)
+	response, err := i.c.Call(request, properties)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return properties, response, nil
+}
+
+func (i *internalEntityPropertyImpl) Get(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, nil, model.ErrNoPropertyKey
+	}
+
+	endpoint, err := i.endpoint(entity, propertyKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.EntityPropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalEntityPropertyImpl) Set(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKey
+	}
+
+	endpoint, err := i.endpoint(entity, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalEntityPropertyImpl) Delete(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKey
+	}
+
+	endpoint, err := i.endpoint(entity, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalEntityPropertyImpl) BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkSetPayloadScheme) (*model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKey
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/properties/%v", i.version, propertyKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalEntityPropertyImpl) BulkDelete(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkDeletePayloadScheme) (*model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, model.ErrNoPropertyKey
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/properties/%v", i.version, propertyKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// endpoint returns the endpoint of the properties of an entity, or of one property when the key is set.
+func (i *internalEntityPropertyImpl) endpoint(entity *model.PropertyEntityScheme, propertyKey string) (string, error) {
+
+	if entity == nil || entity.ID == "" {
+		return "", model.ErrNoPropertyEntity
+	}
+
+	var endpoint string
+	params := url.Values{}
+
+	switch entity.Kind {
+	case model.PropertyEntityIssue:
+		endpoint = fmt.Sprintf("rest/api/%v/issue/%v/properties", i.version, entity.ID)
+	case model.PropertyEntityProject:
+		endpoint = fmt.Sprintf("rest/api/%v/project/%v/properties", i.version, entity.ID)
+	case model.PropertyEntityIssueType:
+		endpoint = fmt.Sprintf("rest/api/%v/issuetype/%v/properties", i.version, entity.ID)
+	case model.PropertyEntityUser:
+		endpoint = fmt.Sprintf("rest/api/%v/user/properties", i.version)
+		params.Add("accountId", entity.ID)
+	case model.PropertyEntityComment:
+		endpoint = fmt.Sprintf("rest/api/%v/comment/%v/properties", i.version, entity.ID)
+	case model.PropertyEntityWorklog:
+
+		if entity.ParentID == "" {
+			return "", model.ErrNoIssueKeyOrID
+		}
+
+		endpoint = fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v/properties", i.version, entity.ParentID, entity.ID)
+	case model.PropertyEntityDashboardItem:
+
+		if entity.ParentID == "" {
+			return "", model.ErrNoDashboardID
+		}
+
+		endpoint = fmt.Sprintf("rest/api/%v/dashboard/%v/items/%v/properties", i.version, entity.ParentID, entity.ID)
+	case model.PropertyEntityApp:
+		endpoint = fmt.Sprintf("rest/atlassian-connect/1/addons/%v/properties", entity.ID)
+	default:
+		return "", model.ErrInvalidPropertyEntity
+	}
+
+	if propertyKey != "" {
+		endpoint += "/" + propertyKey
+	}
+
+	if len(params) != 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	return endpoint, nil
+}
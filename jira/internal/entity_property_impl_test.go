@@ -0,0 +1,1133 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalEntityPropertyImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx    context.Context
+		entity *model.PropertyEntityScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PropertyPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:    context.Background(),
+				entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issuetype/10001/properties",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PropertyPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the property entity is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				entity: nil,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntity,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:    context.Background(),
+				entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.PropertyPageScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed. Please fix me"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := propertyService.Gets(testCase.args.ctx, testCase.args.entity)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		entity      *model.PropertyEntityScheme
+		propertyKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.EntityPropertyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.EntityPropertyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the property entity is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      nil,
+				propertyKey: "integration-state",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntity,
+		},
+
+		{
+			name:   "when the propertyKey is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the http call cannot be executed",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.EntityPropertyScheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed. Please fix me"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := propertyService.Get(testCase.args.ctx, testCase.args.entity, testCase.args.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_Set(t *testing.T) {
+
+	payloadMocked := map[string]interface{}{"synced": true, "revision": 42}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		entity      *model.PropertyEntityScheme
+		propertyKey string
+		value       interface{}
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+				value:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+				value:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issuetype/10001/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the property entity is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      nil,
+				propertyKey: "integration-state",
+				value:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntity,
+		},
+
+		{
+			name:   "when the propertyKey is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "",
+				value:       payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+				value:       payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := propertyService.Set(testCase.args.ctx, testCase.args.entity, testCase.args.propertyKey, testCase.args.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_Delete(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		entity      *model.PropertyEntityScheme
+		propertyKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the property entity is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      nil,
+				propertyKey: "integration-state",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntity,
+		},
+
+		{
+			name:   "when the propertyKey is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssueType, ID: "10001"},
+				propertyKey: "integration-state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issuetype/10001/properties/integration-state",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := propertyService.Delete(testCase.args.ctx, testCase.args.entity, testCase.args.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_BulkSet(t *testing.T) {
+
+	payloadMocked := &model.IssuePropertyBulkSetPayloadScheme{
+		Value:  map[string]interface{}{"synced": true},
+		Filter: &model.IssuePropertyBulkFilterScheme{EntityIDs: []int{10001, 10002}},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		propertyKey string
+		payload     *model.IssuePropertyBulkSetPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the propertyKey is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "",
+				payload:     payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := propertyService.BulkSet(testCase.args.ctx, testCase.args.propertyKey, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_BulkDelete(t *testing.T) {
+
+	payloadMocked := &model.IssuePropertyBulkDeletePayloadScheme{
+		EntityIDs: []int{10001, 10002},
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		propertyKey string
+		payload     *model.IssuePropertyBulkDeletePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/2/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the propertyKey is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "",
+				payload:     payloadMocked,
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				propertyKey: "integration-state",
+				payload:     payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/issue/properties/integration-state",
+					"", payloadMocked).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			propertyService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := propertyService.BulkDelete(testCase.args.ctx, testCase.args.propertyKey, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+		})
+	}
+}
+
+func Test_NewEntityPropertyService(t *testing.T) {
+
+	type args struct {
+		client  service.Connector
+		version string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				client:  nil,
+				version: "3",
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the version is not provided",
+			args: args{
+				client:  nil,
+				version: "",
+			},
+			wantErr: true,
+			err:     model.ErrNoVersionProvided,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := NewEntityPropertyService(testCase.args.client, testCase.args.version)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, got, nil)
+			}
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_endpoint(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		entity      *model.PropertyEntityScheme
+		propertyKey string
+		want        string
+		Err         error
+	}{
+		{
+			name:        "when the entity is an issue",
+			entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityIssue, ID: "KP-1"},
+			propertyKey: "state",
+			want:        "rest/api/3/issue/KP-1/properties/state",
+		},
+
+		{
+			name:   "when the entity is a project",
+			entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityProject, ID: "KP"},
+			want:   "rest/api/3/project/KP/properties",
+		},
+
+		{
+			name:        "when the entity is a user",
+			entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityUser, ID: "5b10ac8d82e05b22cc7d4ef5"},
+			propertyKey: "state",
+			want:        "rest/api/3/user/properties/state?accountId=5b10ac8d82e05b22cc7d4ef5",
+		},
+
+		{
+			name:   "when the entity is a comment",
+			entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityComment, ID: "10010"},
+			want:   "rest/api/3/comment/10010/properties",
+		},
+
+		{
+			name:        "when the entity is a worklog",
+			entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityWorklog, ID: "10020", ParentID: "KP-1"},
+			propertyKey: "state",
+			want:        "rest/api/3/issue/KP-1/worklog/10020/properties/state",
+		},
+
+		{
+			name:   "when the entity is a dashboard item",
+			entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityDashboardItem, ID: "10030", ParentID: "10000"},
+			want:   "rest/api/3/dashboard/10000/items/10030/properties",
+		},
+
+		{
+			name:        "when the entity is an app",
+			entity:      &model.PropertyEntityScheme{Kind: model.PropertyEntityApp, ID: "com.example.app"},
+			propertyKey: "state",
+			want:        "rest/atlassian-connect/1/addons/com.example.app/properties/state",
+		},
+
+		{
+			name:   "when the worklog issue is not provided",
+			entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityWorklog, ID: "10020"},
+			Err:    model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the dashboard is not provided",
+			entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityDashboardItem, ID: "10030"},
+			Err:    model.ErrNoDashboardID,
+		},
+
+		{
+			name:   "when the entity kind is not supported",
+			entity: &model.PropertyEntityScheme{Kind: "board", ID: "1"},
+			Err:    model.ErrInvalidPropertyEntity,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			impl := &internalEntityPropertyImpl{version: "3"}
+
+			got, err := impl.endpoint(testCase.entity, testCase.propertyKey)
+			if testCase.Err != nil {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewExpressionService creates a new instance of ExpressionService.
+func NewExpressionService(client service.Connector, version string) (*ExpressionService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &ExpressionService{
+		internalClient: &internalExpressionImpl{c: client, version: version},
+	}, nil
+}
+
+// ExpressionService provides methods to evaluate and analyse the Jira expressions.
+type ExpressionService struct {
+	// internalClient is the connector interface for Jira expression operations.
+	internalClient jira.ExpressionConnector
+}
+
+// Evaluate evaluates a Jira expression and returns its value.
+//
+// The issues of a JQL context are paginated by token, use ExpressionJQLContextScheme.Next to fetch the next pages.
+//
+// Decode the value with models.ExpressionValue, e.g. models.ExpressionValue[[]string](result).
+//
+// POST /rest/api/{2-3}/expression/evaluate
+func (e *ExpressionService) Evaluate(ctx context.Context, payload *model.ExpressionEvaluationPayloadScheme, expand []string) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Evaluate(ctx, payload, expand)
+}
+
+// Analyse analyses Jira expressions for syntax errors, type errors or complexity.
+//
+// The check parameter is "syntax", "type" or "complexity", the type check is used when empty.
+//
+// POST /rest/api/{2-3}/expression/analyse
+func (e *ExpressionService) Analyse(ctx context.Context, payload *model.ExpressionAnalysisPayloadScheme, check string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Analyse(ctx, payload, check)
+}
+
+type internalExpressionImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalExpressionImpl) Evaluate(ctx context.Context, payload *model.ExpressionEvaluationPayloadScheme, expand []string) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || payload.Expression == "" {
+		return nil, nil, model.ErrNoExpression
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/expression/evaluate", i.version))
+
+	if len(expand) != 0 {
+		params := url.Values{}
+		params.Add("expand", strings.Join(expand, ","))
+
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.ExpressionEvaluationScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalExpressionImpl) Analyse(ctx context.Context, payload *model.ExpressionAnalysisPayloadScheme, check string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.Expressions) == 0 {
+		return nil, nil, model.ErrNoExpression
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/expression/analyse", i.version))
+
+	if check != "" {
+		params := url.Values{}
+		params.Add("check", check)
+
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	analysis := new(model.ExpressionAnalysisScheme)
+	response, err := i.c.Call(request, analysis)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return analysis, response, nil
+}
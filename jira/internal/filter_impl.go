@@ -112,6 +112,36 @@ func (f *FilterService) Change(ctx context.Context, filterID int, accountID stri
 	return f.internalClient.Change(ctx, filterID, accountID)
 }
 
+// Columns returns the columns of the issue navigator of a filter.
+//
+// GET /rest/api/{2-3}/filter/{filterID}/columns
+func (f *FilterService) Columns(ctx context.Context, filterID int) ([]*model.FilterColumnScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Columns(ctx, filterID)
+}
+
+// SetColumns sets the columns of the issue navigator of a filter, in the order of the field IDs.
+//
+// PUT /rest/api/{2-3}/filter/{filterID}/columns
+func (f *FilterService) SetColumns(ctx context.Context, filterID int, columns []string) (*model.ResponseScheme, error) {
+	return f.internalClient.SetColumns(ctx, filterID, columns)
+}
+
+// ResetColumns resets the columns of a filter to the default columns of the user.
+//
+// DELETE /rest/api/{2-3}/filter/{filterID}/columns
+func (f *FilterService) ResetColumns(ctx context.Context, filterID int) (*model.ResponseScheme, error) {
+	return f.internalClient.ResetColumns(ctx, filterID)
+}
+
+// Subscriptions returns the users and groups subscribed to a filter.
+//
+// The subscriptions are managed in Jira, the REST API only returns them as an expansion of the filter.
+//
+// GET /rest/api/{2-3}/filter/{filterID}?expand=subscriptions
+func (f *FilterService) Subscriptions(ctx context.Context, filterID int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error) {
+	return f.internalClient.Subscriptions(ctx, filterID)
+}
+
 type internalFilterServiceImpl struct {
 	c       service.Connector
 	version string
@@ -325,3 +355,75 @@ func (i *internalFilterServiceImpl) Change(ctx context.Context, filterID int, ac
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalFilterServiceImpl) Columns(ctx context.Context, filterID int) ([]*model.FilterColumnScheme, *model.ResponseScheme, error) {
+
+	if filterID == 0 {
+		return nil, nil, model.ErrNoFilterID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/columns", i.version, filterID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var columns []*model.FilterColumnScheme
+	response, err := i.c.Call(request, &columns)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return columns, response, nil
+}
+
+func (i *internalFilterServiceImpl) SetColumns(ctx context.Context, filterID int, columns []string) (*model.ResponseScheme, error) {
+
+	if filterID == 0 {
+		return nil, model.ErrNoFilterID
+	}
+
+	if len(columns) == 0 {
+		return nil, model.ErrNoFilterColumns
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/columns", i.version, filterID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.FilterColumnsPayloadScheme{Columns: columns})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalFilterServiceImpl) ResetColumns(ctx context.Context, filterID int) (*model.ResponseScheme, error) {
+
+	if filterID == 0 {
+		return nil, model.ErrNoFilterID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/columns", i.version, filterID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalFilterServiceImpl) Subscriptions(ctx context.Context, filterID int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error) {
+
+	filter, response, err := i.Get(ctx, filterID, []string{"subscriptions"})
+	if err != nil {
+		return nil, response, err
+	}
+
+	if filter.Subscriptions == nil {
+		return &model.FilterSubscriptionPageScheme{}, response, nil
+	}
+
+	return filter.Subscriptions, response, nil
+}
@@ -41,6 +41,8 @@ func (f *FilterShareService) Scope(ctx context.Context) (*model.ShareFilterScope
 
 // SetScope sets the default sharing for new filters and dashboards for a user.
 //
+// The scope is one of models.ShareScopeGlobal, models.ShareScopeAuthenticated or models.ShareScopePrivate.
+//
 // PUT /rest/api/{2-3}/filter/defaultShareScope
 //
 // https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#set-default-share-scope
@@ -121,6 +123,14 @@ func (i *internalFilterShareImpl) Scope(ctx context.Context) (*model.ShareFilter
 
 func (i *internalFilterShareImpl) SetScope(ctx context.Context, scope string) (*model.ResponseScheme, error) {
 
+	switch scope {
+	case "":
+		return nil, model.ErrNoShareScope
+	case model.ShareScopeGlobal, model.ShareScopeAuthenticated, model.ShareScopePrivate:
+	default:
+		return nil, model.ErrInvalidShareScope
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/filter/defaultShareScope", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.ShareFilterScopeScheme{Scope: scope})
@@ -241,6 +241,28 @@ func TestFilterShareService_SetScope(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the scope is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:   context.Background(),
+				scope: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoShareScope,
+		},
+
+		{
+			name:   "when the scope is not valid",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:   context.Background(),
+				scope: "PROJECT",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidShareScope,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
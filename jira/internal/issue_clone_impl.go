@@ -0,0 +1,945 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// cloneSkippedFields contains the fields never copied by a clone, they're either set by the clone itself
+// or copied using their own endpoints.
+var cloneSkippedFields = map[string]bool{
+	"project":    true,
+	"issuetype":  true,
+	"parent":     true,
+	"issuelinks": true,
+	"attachment": true,
+	"subtasks":   true,
+	"comment":    true,
+	"worklog":    true,
+}
+
+// cloneMaxResults is the page size used to fetch the metadata, the comments and the child issues.
+const cloneMaxResults = 100
+
+// issueCloneServices contains the services used by a clone, the functions wrap the services whose
+// payloads depend on the API version.
+type issueCloneServices struct {
+	get        func(ctx context.Context, issueKeyOrID string) (*model.IssueCloneSourceScheme, *model.ResponseScheme, error)
+	create     func(ctx context.Context, fields map[string]interface{}) (*model.IssueResponseScheme, *model.ResponseScheme, error)
+	search     func(ctx context.Context, jql, nextPageToken string) ([]string, string, *model.ResponseScheme, error)
+	comments   func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*cloneComment, int, *model.ResponseScheme, error)
+	comment    func(ctx context.Context, issueKeyOrID string, comment *cloneComment) (*model.ResponseScheme, error)
+	link       func(ctx context.Context, link *model.IssueCloneLinkScheme) (*model.ResponseScheme, error)
+	metadata   *MetadataService
+	remoteLink *RemoteLinkService
+	attachment *IssueAttachmentService
+}
+
+// cloneComment represents a comment copied by a clone, the body is a document (ADF) on the version 3.
+type cloneComment struct {
+	ID         string
+	Body       interface{}
+	Visibility *model.CommentVisibilityScheme
+}
+
+// cloneIssue clones an issue, along with the items selected on the options.
+// The fields and issue types are remapped using the create metadata of the target project.
+func cloneIssue(ctx context.Context, services *issueCloneServices, issueKeyOrID string, options *model.IssueCloneOptionsScheme) (
+	*model.IssueCloneScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	if services == nil {
+		return nil, nil, model.ErrNoIssueCloneServices
+	}
+
+	if options == nil {
+		options = &model.IssueCloneOptionsScheme{}
+	}
+
+	cloner := &issueCloner{
+		services:   services,
+		options:    options,
+		issueTypes: make(map[string][]*cloneIssueType),
+		fields:     make(map[string][]*cloneField),
+		clones:     make(map[string]*model.IssueCloneScheme),
+	}
+
+	clone, err := cloner.clone(ctx, issueKeyOrID, "")
+	if err != nil {
+		return clone, cloner.response, err
+	}
+
+	if err = cloner.link(ctx); err != nil {
+		return clone, cloner.response, err
+	}
+
+	return clone, cloner.response, nil
+}
+
+// cloneServices returns the services used to clone the issues with the ADF payloads,
+// or nil when the issue sub-services aren't set.
+func (i *IssueADFService) cloneServices() *issueCloneServices {
+
+	if i.Attachment == nil || i.Comment == nil || i.Link == nil || i.Link.Remote == nil || i.Metadata == nil || i.Search == nil {
+		return nil
+	}
+
+	return &issueCloneServices{
+		get: func(ctx context.Context, issueKeyOrID string) (*model.IssueCloneSourceScheme, *model.ResponseScheme, error) {
+			_, response, err := i.Get(ctx, issueKeyOrID, nil, []string{"names"})
+			return cloneSource(response, err)
+		},
+		create: func(ctx context.Context, fields map[string]interface{}) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+			return i.Create(ctx, &model.IssueScheme{}, &model.CustomFields{Fields: []map[string]interface{}{{"fields": fields}}})
+		},
+		search: func(ctx context.Context, jql, nextPageToken string) ([]string, string, *model.ResponseScheme, error) {
+
+			page, response, err := i.Search.SearchJQL(ctx, jql, []string{"issuetype"}, nil, cloneMaxResults, nextPageToken)
+			if err != nil {
+				return nil, "", response, err
+			}
+
+			keys := make([]string, 0, len(page.Issues))
+			for _, issue := range page.Issues {
+				keys = append(keys, issue.Key)
+			}
+
+			return keys, page.NextPageToken, response, nil
+		},
+		comments: func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*cloneComment, int, *model.ResponseScheme, error) {
+
+			page, response, err := i.Comment.Gets(ctx, issueKeyOrID, "", nil, startAt, maxResults)
+			if err != nil {
+				return nil, 0, response, err
+			}
+
+			comments := make([]*cloneComment, 0, len(page.Comments))
+			for _, comment := range page.Comments {
+				comments = append(comments, &cloneComment{ID: comment.ID, Body: comment.Body, Visibility: comment.Visibility})
+			}
+
+			return comments, page.Total, response, nil
+		},
+		comment: func(ctx context.Context, issueKeyOrID string, comment *cloneComment) (*model.ResponseScheme, error) {
+
+			body, _ := comment.Body.(*model.CommentNodeScheme)
+
+			_, response, err := i.Comment.Add(ctx, issueKeyOrID, &model.CommentPayloadScheme{Body: body, Visibility: comment.Visibility}, nil)
+			return response, err
+		},
+		link: func(ctx context.Context, link *model.IssueCloneLinkScheme) (*model.ResponseScheme, error) {
+			return i.Link.Create(ctx, &model.LinkPayloadSchemeV3{
+				Type:         &model.LinkTypeScheme{Name: link.Type},
+				InwardIssue:  &model.LinkedIssueScheme{Key: link.InwardIssue},
+				OutwardIssue: &model.LinkedIssueScheme{Key: link.OutwardIssue},
+			})
+		},
+		metadata:   i.Metadata,
+		remoteLink: i.Link.Remote,
+		attachment: i.Attachment,
+	}
+}
+
+// cloneServices returns the services used to clone the issues with the rich text payloads,
+// or nil when the issue sub-services aren't set.
+func (i *IssueRichTextService) cloneServices() *issueCloneServices {
+
+	if i.Attachment == nil || i.Comment == nil || i.Link == nil || i.Link.Remote == nil || i.Metadata == nil || i.Search == nil {
+		return nil
+	}
+
+	return &issueCloneServices{
+		get: func(ctx context.Context, issueKeyOrID string) (*model.IssueCloneSourceScheme, *model.ResponseScheme, error) {
+			_, response, err := i.Get(ctx, issueKeyOrID, nil, []string{"names"})
+			return cloneSource(response, err)
+		},
+		create: func(ctx context.Context, fields map[string]interface{}) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+			return i.Create(ctx, &model.IssueSchemeV2{}, &model.CustomFields{Fields: []map[string]interface{}{{"fields": fields}}})
+		},
+		search: func(ctx context.Context, jql, nextPageToken string) ([]string, string, *model.ResponseScheme, error) {
+
+			page, response, err := i.Search.SearchJQL(ctx, jql, []string{"issuetype"}, nil, cloneMaxResults, nextPageToken)
+			if err != nil {
+				return nil, "", response, err
+			}
+
+			keys := make([]string, 0, len(page.Issues))
+			for _, issue := range page.Issues {
+				keys = append(keys, issue.Key)
+			}
+
+			return keys, page.NextPageToken, response, nil
+		},
+		comments: func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*cloneComment, int, *model.ResponseScheme, error) {
+
+			page, response, err := i.Comment.Gets(ctx, issueKeyOrID, "", nil, startAt, maxResults)
+			if err != nil {
+				return nil, 0, response, err
+			}
+
+			comments := make([]*cloneComment, 0, len(page.Comments))
+			for _, comment := range page.Comments {
+				comments = append(comments, &cloneComment{ID: comment.ID, Body: comment.Body, Visibility: comment.Visibility})
+			}
+
+			return comments, page.Total, response, nil
+		},
+		comment: func(ctx context.Context, issueKeyOrID string, comment *cloneComment) (*model.ResponseScheme, error) {
+
+			body, _ := comment.Body.(string)
+
+			_, response, err := i.Comment.Add(ctx, issueKeyOrID, &model.CommentPayloadSchemeV2{Body: body, Visibility: comment.Visibility}, nil)
+			return response, err
+		},
+		link: func(ctx context.Context, link *model.IssueCloneLinkScheme) (*model.ResponseScheme, error) {
+			return i.Link.Create(ctx, &model.LinkPayloadSchemeV2{
+				Type:         &model.LinkTypeScheme{Name: link.Type},
+				InwardIssue:  &model.LinkedIssueScheme{Key: link.InwardIssue},
+				OutwardIssue: &model.LinkedIssueScheme{Key: link.OutwardIssue},
+			})
+		},
+		metadata:   i.Metadata,
+		remoteLink: i.Link.Remote,
+		attachment: i.Attachment,
+	}
+}
+
+// cloneSource decodes the source issue of a clone from the raw response, the fields are kept as returned by the API.
+func cloneSource(response *model.ResponseScheme, err error) (*model.IssueCloneSourceScheme, *model.ResponseScheme, error) {
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	source := new(model.IssueCloneSourceScheme)
+	if err = json.Unmarshal(response.Bytes.Bytes(), source); err != nil {
+		return nil, response, err
+	}
+
+	return source, response, nil
+}
+
+type issueCloner struct {
+	services *issueCloneServices
+	options  *model.IssueCloneOptionsScheme
+
+	issueTypes map[string][]*cloneIssueType       // The issue types, keyed by project.
+	fields     map[string][]*cloneField           // The create fields, keyed by project and issue type.
+	clones     map[string]*model.IssueCloneScheme // The clones, keyed by source issue key.
+	sources    []*cloneSourceIssue                // The source issues, in the order they were cloned.
+	response   *model.ResponseScheme              // The last response received.
+}
+
+type cloneSourceIssue struct {
+	model.IssueCloneSourceScheme
+	clone *model.IssueCloneScheme
+}
+
+type cloneIssueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type cloneField struct {
+	FieldID       string                        `json:"fieldId"`
+	Key           string                        `json:"key"`
+	Name          string                        `json:"name"`
+	Schema        *model.IssueFieldSchemaScheme `json:"schema"`
+	AllowedValues []*cloneOption                `json:"allowedValues"`
+}
+
+type cloneOption struct {
+	ID       string         `json:"id"`
+	Value    string         `json:"value"`
+	Children []*cloneOption `json:"children"`
+}
+
+func (c *issueCloner) clone(ctx context.Context, issueKeyOrID, parentRef string) (*model.IssueCloneScheme, error) {
+
+	source, err := c.source(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	if clone, ok := c.clones[source.Key]; ok {
+		return clone, nil
+	}
+
+	project := c.options.ProjectKeyOrID
+	if project == "" {
+		project = cloneStringKey(source.Fields["project"], "key", "id")
+	}
+
+	issueType, err := c.issueType(ctx, project, source.Fields["issuetype"])
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := c.createFields(ctx, project, issueType.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := &model.IssueCloneScheme{
+		SourceKey:   source.Key,
+		Project:     project,
+		IssueTypeID: issueType.ID,
+		Fields: map[string]interface{}{
+			"project":   cloneReference(project),
+			"issuetype": map[string]interface{}{"id": issueType.ID},
+		},
+	}
+
+	c.copyFields(source, clone, fields)
+
+	if parentRef == "" {
+		parentRef = cloneStringKey(source.Fields["parent"], "key")
+	}
+
+	if _, ok := findCloneField(fields, "parent", ""); ok && parentRef != "" {
+		clone.Fields["parent"] = map[string]interface{}{"key": parentRef}
+	}
+
+	if c.options.Mapper != nil {
+		if err = c.options.Mapper(ctx, &source.IssueCloneSourceScheme, clone); err != nil {
+			return clone, err
+		}
+	}
+
+	if !c.options.DryRun {
+
+		created, response, err := c.services.create(ctx, clone.Fields)
+		if err != nil {
+			return clone, fmt.Errorf("jira: unable to clone the issue %v: %w", source.Key, err)
+		}
+
+		c.response = response
+		clone.ID, clone.Key = created.ID, created.Key
+	}
+
+	c.clones[source.Key] = clone
+	source.clone = clone
+	c.sources = append(c.sources, source)
+
+	if err = c.copyItems(ctx, source, clone); err != nil {
+		return clone, err
+	}
+
+	if !c.options.Children {
+		return clone, nil
+	}
+
+	children, err := c.children(ctx, source.Key)
+	if err != nil {
+		return clone, err
+	}
+
+	for _, childKey := range children {
+
+		child, err := c.clone(ctx, childKey, cloneRef(clone))
+		if err != nil {
+			return clone, err
+		}
+
+		clone.Children = append(clone.Children, child)
+	}
+
+	return clone, nil
+}
+
+// copyFields copies the source fields available on the target create screen, matching them by ID first
+// and by name otherwise, e.g. a custom field with a different ID on the target project.
+func (c *issueCloner) copyFields(source *cloneSourceIssue, clone *model.IssueCloneScheme, fields []*cloneField) {
+
+	excluded := make(map[string]bool, len(c.options.ExcludeFields))
+	for _, fieldID := range c.options.ExcludeFields {
+		excluded[fieldID] = true
+	}
+
+	for fieldID, raw := range source.Fields {
+
+		if cloneSkippedFields[fieldID] || excluded[fieldID] || raw == nil {
+			continue
+		}
+
+		field, ok := findCloneField(fields, fieldID, source.Names[fieldID])
+		if !ok {
+
+			// The system fields missing on the create screen are read-only (e.g. status or created),
+			// only the custom fields are reported.
+			if strings.HasPrefix(fieldID, "customfield_") && cloneFieldValue(fieldID, nil, raw) != nil {
+				clone.DroppedFields = append(clone.DroppedFields, fieldID)
+			}
+
+			continue
+		}
+
+		value := cloneFieldValue(field.FieldID, field.Schema, raw)
+		if value == nil {
+			continue
+		}
+
+		// The option IDs are different on each field configuration, the options are matched by value.
+		if isCloneOptionField(field.Schema) {
+			if value, ok = cloneOptionValue(field.AllowedValues, raw); !ok {
+				clone.DroppedFields = append(clone.DroppedFields, fieldID)
+				continue
+			}
+		}
+
+		clone.Fields[field.FieldID] = value
+	}
+
+	sort.Strings(clone.DroppedFields)
+
+	if summary, ok := clone.Fields["summary"].(string); ok && c.options.SummaryPrefix != "" {
+		clone.Fields["summary"] = c.options.SummaryPrefix + summary
+	}
+}
+
+// copyItems copies the remote links, attachments and comments of the source issue.
+func (c *issueCloner) copyItems(ctx context.Context, source *cloneSourceIssue, clone *model.IssueCloneScheme) error {
+
+	if c.options.RemoteLinks {
+
+		links, err := c.remoteLinks(ctx, source.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, link := range links {
+
+			if link.Object != nil {
+				clone.RemoteLinks = append(clone.RemoteLinks, link.Object.URL)
+			}
+
+			if c.options.DryRun {
+				continue
+			}
+
+			link.ID, link.Self = 0, ""
+			if _, c.response, err = c.services.remoteLink.Create(ctx, clone.Key, link); err != nil {
+				return fmt.Errorf("jira: unable to copy the remote links of the issue %v: %w", source.Key, err)
+			}
+		}
+	}
+
+	if c.options.Attachments {
+
+		attachments, _ := source.Fields["attachment"].([]interface{})
+		for _, attachment := range attachments {
+
+			attachmentID, fileName := cloneStringKey(attachment, "id"), cloneStringKey(attachment, "filename")
+			if attachmentID == "" {
+				continue
+			}
+
+			clone.Attachments = append(clone.Attachments, fileName)
+
+			if c.options.DryRun {
+				continue
+			}
+
+			if err := c.copyAttachment(ctx, clone.Key, attachmentID, fileName); err != nil {
+				return fmt.Errorf("jira: unable to copy the attachment %v of the issue %v: %w", attachmentID, source.Key, err)
+			}
+		}
+	}
+
+	if c.options.Comments {
+
+		comments, err := c.comments(ctx, source.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, comment := range comments {
+
+			clone.Comments = append(clone.Comments, comment.ID)
+
+			if c.options.DryRun {
+				continue
+			}
+
+			if c.response, err = c.services.comment(ctx, clone.Key, comment); err != nil {
+				return fmt.Errorf("jira: unable to copy the comment %v of the issue %v: %w", comment.ID, source.Key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// link copies the issue links once all the issues are cloned, so the links between the source issues
+// are created between their clones.
+func (c *issueCloner) link(ctx context.Context) error {
+
+	seen := make(map[string]bool)
+
+	for _, source := range c.sources {
+
+		if c.options.LinkType != "" {
+
+			link := &model.IssueCloneLinkScheme{Type: c.options.LinkType, InwardIssue: cloneRef(source.clone), OutwardIssue: source.Key}
+			if err := c.createLink(ctx, source.clone, link); err != nil {
+				return err
+			}
+		}
+
+		if !c.options.Links {
+			continue
+		}
+
+		links, _ := source.Fields["issuelinks"].([]interface{})
+		for _, raw := range links {
+
+			linkID := cloneStringKey(raw, "id")
+			if linkID != "" && seen[linkID] {
+				continue
+			}
+
+			seen[linkID] = true
+
+			node, _ := raw.(map[string]interface{})
+			link := &model.IssueCloneLinkScheme{Type: cloneStringKey(node["type"], "name")}
+
+			// The linked issue keeps its side of the link, the source issue is replaced by its clone.
+			if outward := cloneStringKey(node["outwardIssue"], "key"); outward != "" {
+				link.InwardIssue, link.OutwardIssue = cloneRef(source.clone), c.ref(outward)
+			} else if inward := cloneStringKey(node["inwardIssue"], "key"); inward != "" {
+				link.InwardIssue, link.OutwardIssue = c.ref(inward), cloneRef(source.clone)
+			} else {
+				continue
+			}
+
+			if err := c.createLink(ctx, source.clone, link); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *issueCloner) createLink(ctx context.Context, clone *model.IssueCloneScheme, link *model.IssueCloneLinkScheme) error {
+
+	clone.Links = append(clone.Links, link)
+
+	if c.options.DryRun {
+		return nil
+	}
+
+	response, err := c.services.link(ctx, link)
+	c.response = response
+	if err != nil {
+		return fmt.Errorf("jira: unable to link the issues %v and %v: %w", link.InwardIssue, link.OutwardIssue, err)
+	}
+
+	return nil
+}
+
+// ref returns the reference of the clone of an issue, or the issue key when the issue wasn't cloned.
+func (c *issueCloner) ref(issueKey string) string {
+
+	if clone, ok := c.clones[issueKey]; ok {
+		return cloneRef(clone)
+	}
+
+	return issueKey
+}
+
+func (c *issueCloner) source(ctx context.Context, issueKeyOrID string) (*cloneSourceIssue, error) {
+
+	source, response, err := c.services.get(ctx, issueKeyOrID)
+	c.response = response
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloneSourceIssue{IssueCloneSourceScheme: *source}, nil
+}
+
+func (c *issueCloner) issueType(ctx context.Context, project string, sourceIssueType interface{}) (*cloneIssueType, error) {
+
+	issueTypes, ok := c.issueTypes[project]
+	if !ok {
+
+		for startAt := 0; ; {
+
+			result, response, err := c.services.metadata.FetchIssueMappings(ctx, project, startAt, cloneMaxResults)
+			c.response = response
+			if err != nil {
+				return nil, err
+			}
+
+			page := struct {
+				IssueTypes []*cloneIssueType `json:"issueTypes"`
+				Values     []*cloneIssueType `json:"values"`
+				Total      int               `json:"total"`
+			}{}
+
+			if err = json.Unmarshal([]byte(result.Raw), &page); err != nil {
+				return nil, err
+			}
+
+			values := append(page.IssueTypes, page.Values...)
+			issueTypes = append(issueTypes, values...)
+
+			startAt += len(values)
+			if len(values) == 0 || startAt >= page.Total {
+				break
+			}
+		}
+
+		c.issueTypes[project] = issueTypes
+	}
+
+	sourceID, sourceName := cloneStringKey(sourceIssueType, "id"), cloneStringKey(sourceIssueType, "name")
+
+	target, ok := c.options.IssueTypes[sourceID]
+	if !ok {
+		if target, ok = c.options.IssueTypes[sourceName]; !ok {
+			target = sourceName
+		}
+	}
+
+	for _, issueType := range issueTypes {
+		if issueType.ID == target || strings.EqualFold(issueType.Name, target) {
+			return issueType, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v (%v)", model.ErrNoCloneIssueType, target, project)
+}
+
+func (c *issueCloner) createFields(ctx context.Context, project, issueTypeID string) ([]*cloneField, error) {
+
+	cacheKey := project + "/" + issueTypeID
+	if fields, ok := c.fields[cacheKey]; ok {
+		return fields, nil
+	}
+
+	var fields []*cloneField
+	for startAt := 0; ; {
+
+		result, response, err := c.services.metadata.FetchFieldMappings(ctx, project, issueTypeID, startAt, cloneMaxResults)
+		c.response = response
+		if err != nil {
+			return nil, err
+		}
+
+		page := struct {
+			Fields  []*cloneField `json:"fields"`
+			Results []*cloneField `json:"results"`
+			Total   int           `json:"total"`
+		}{}
+
+		if err = json.Unmarshal([]byte(result.Raw), &page); err != nil {
+			return nil, err
+		}
+
+		values := append(page.Fields, page.Results...)
+		fields = append(fields, values...)
+
+		startAt += len(values)
+		if len(values) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	c.fields[cacheKey] = fields
+	return fields, nil
+}
+
+func (c *issueCloner) children(ctx context.Context, issueKey string) ([]string, error) {
+
+	var keys []string
+	for nextPageToken := ""; ; {
+
+		page, token, response, err := c.services.search(ctx, fmt.Sprintf("parent = %v ORDER BY created ASC", strconv.Quote(issueKey)), nextPageToken)
+		c.response = response
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, page...)
+
+		if token == "" || len(page) == 0 {
+			return keys, nil
+		}
+
+		nextPageToken = token
+	}
+}
+
+func (c *issueCloner) remoteLinks(ctx context.Context, issueKey string) ([]*model.RemoteLinkScheme, error) {
+
+	links, response, err := c.services.remoteLink.Gets(ctx, issueKey, "")
+	c.response = response
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (c *issueCloner) comments(ctx context.Context, issueKey string) ([]*cloneComment, error) {
+
+	var comments []*cloneComment
+	for startAt := 0; ; {
+
+		page, total, response, err := c.services.comments(ctx, issueKey, startAt, cloneMaxResults)
+		c.response = response
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, page...)
+
+		startAt += len(page)
+		if len(page) == 0 || startAt >= total {
+			return comments, nil
+		}
+	}
+}
+
+// copyAttachment streams an attachment to the clone, the contents are uploaded while they're downloaded.
+func (c *issueCloner) copyAttachment(ctx context.Context, issueKey, attachmentID, fileName string) error {
+
+	content, response, err := c.services.attachment.stream(ctx, attachmentID)
+	c.response = response
+	if err != nil {
+		return err
+	}
+
+	defer content.Close()
+
+	_, c.response, err = c.services.attachment.Add(ctx, issueKey, fileName, content)
+	return err
+}
+
+// cloneRef returns the key of the clone, or the key of its source issue on the dry runs.
+func cloneRef(clone *model.IssueCloneScheme) string {
+
+	if clone.Key != "" {
+		return clone.Key
+	}
+
+	return clone.SourceKey
+}
+
+// cloneReference returns the reference of a project or an issue, using the ID when it's numeric.
+func cloneReference(keyOrID string) map[string]interface{} {
+
+	if _, err := strconv.Atoi(keyOrID); err == nil {
+		return map[string]interface{}{"id": keyOrID}
+	}
+
+	return map[string]interface{}{"key": keyOrID}
+}
+
+func findCloneField(fields []*cloneField, fieldID, name string) (*cloneField, bool) {
+
+	for _, field := range fields {
+		if field.FieldID == fieldID || field.Key == fieldID {
+			return field, true
+		}
+	}
+
+	if name == "" {
+		return nil, false
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+// cloneFieldValue converts a field value, as returned by the API, to its create representation,
+// e.g. the objects are reduced to their ID. It returns nil when the field is empty.
+func cloneFieldValue(fieldID string, schema *model.IssueFieldSchemaScheme, raw interface{}) interface{} {
+
+	switch value := raw.(type) {
+	case nil:
+		return nil
+
+	case string:
+		if value == "" {
+			return nil
+		}
+
+		return value
+
+	case map[string]interface{}:
+		if fieldID == "timetracking" {
+
+			estimates := make(map[string]interface{})
+			for _, key := range []string{"originalEstimate", "remainingEstimate"} {
+				if estimate, ok := value[key]; ok {
+					estimates[key] = estimate
+				}
+			}
+
+			if len(estimates) == 0 {
+				return nil
+			}
+
+			return estimates
+		}
+
+		if accountID, ok := value["accountId"]; ok {
+			return map[string]interface{}{"accountId": accountID}
+		}
+
+		if id, ok := value["id"]; ok {
+
+			reference := map[string]interface{}{"id": id}
+			if child := cloneFieldValue("", nil, value["child"]); child != nil {
+				reference["child"] = child
+			}
+
+			return reference
+		}
+
+		// The documents (ADF) and the other objects without ID are copied as they are.
+		return value
+
+	case []interface{}:
+		if schema != nil && schema.Custom == "com.pyxis.greenhopper.jira:gh-sprint" {
+
+			// The sprint field takes a single sprint ID, the last sprint not closed is kept.
+			for index := len(value) - 1; index >= 0; index-- {
+
+				sprint, _ := value[index].(map[string]interface{})
+				if sprint != nil && sprint["state"] != "closed" && sprint["id"] != nil {
+					return sprint["id"]
+				}
+			}
+
+			return nil
+		}
+
+		var values []interface{}
+		for _, element := range value {
+			if converted := cloneFieldValue("", nil, element); converted != nil {
+				values = append(values, converted)
+			}
+		}
+
+		if len(values) == 0 {
+			return nil
+		}
+
+		return values
+	}
+
+	return raw
+}
+
+// cloneStringKey returns the first string (or number) value found on the object keys.
+func cloneStringKey(raw interface{}, keys ...string) string {
+
+	node, ok := raw.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	for _, key := range keys {
+
+		switch value := node[key].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+
+	return ""
+}
+
+// isCloneOptionField reports whether the field takes the options of a select list,
+// e.g. a single, multiple or cascading select custom field.
+func isCloneOptionField(schema *model.IssueFieldSchemaScheme) bool {
+
+	if schema == nil {
+		return false
+	}
+
+	return schema.Type == "option" || schema.Type == "option-with-child" || (schema.Type == "array" && schema.Items == "option")
+}
+
+// cloneOptionValue matches the source options by value against the options allowed on the target field.
+// It returns false when an option, or the child option of a cascading select, isn't allowed.
+func cloneOptionValue(allowed []*cloneOption, raw interface{}) (interface{}, bool) {
+
+	switch value := raw.(type) {
+	case map[string]interface{}:
+
+		option := findCloneOption(allowed, cloneStringKey(value, "value"))
+		if option == nil {
+			return nil, false
+		}
+
+		reference := map[string]interface{}{"id": option.ID}
+
+		if child, ok := value["child"].(map[string]interface{}); ok {
+
+			childOption := findCloneOption(option.Children, cloneStringKey(child, "value"))
+			if childOption == nil {
+				return nil, false
+			}
+
+			reference["child"] = map[string]interface{}{"id": childOption.ID}
+		}
+
+		return reference, true
+
+	case []interface{}:
+
+		values := make([]interface{}, 0, len(value))
+		for _, element := range value {
+
+			converted, ok := cloneOptionValue(allowed, element)
+			if !ok {
+				return nil, false
+			}
+
+			values = append(values, converted)
+		}
+
+		return values, true
+	}
+
+	return nil, false
+}
+
+func findCloneOption(options []*cloneOption, value string) *cloneOption {
+
+	if value == "" {
+		return nil
+	}
+
+	for _, option := range options {
+		if option.Value == value {
+			return option
+		}
+	}
+
+	return nil
+}
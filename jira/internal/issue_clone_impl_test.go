@@ -0,0 +1,408 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+// cloneRequestMocked records a request sent by the cloner.
+type cloneRequestMocked struct {
+	Method, Endpoint, ContentType string
+	Body                          interface{}
+}
+
+// cloneConnectorMocked serves the responses keyed by method and endpoint, the handlers receive the request body.
+func cloneConnectorMocked(t *testing.T, responses map[string]func(body interface{}) string, requests *[]*cloneRequestMocked) service.Connector {
+
+	client := mocks.NewConnector(t)
+	bodies := make(map[*http.Request]*cloneRequestMocked)
+
+	client.On("NewRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, method, endpoint, contentType string, body interface{}) (*http.Request, error) {
+
+			request, err := http.NewRequest(method, endpoint, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			recorded := &cloneRequestMocked{Method: method, Endpoint: endpoint, ContentType: contentType, Body: body}
+			bodies[request] = recorded
+			*requests = append(*requests, recorded)
+
+			return request, nil
+		})
+
+	client.On("Call", mock.Anything, mock.Anything).
+		Return(func(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
+
+			recorded := bodies[request]
+
+			if reader, ok := recorded.Body.(io.Reader); ok {
+
+				content, err := io.ReadAll(reader)
+				if err != nil {
+					return nil, err
+				}
+
+				recorded.Body = string(content)
+			}
+
+			handler, ok := responses[recorded.Method+" "+recorded.Endpoint]
+			if !ok {
+				return &model.ResponseScheme{Code: http.StatusNotFound}, model.ErrNotFound
+			}
+
+			response := &model.ResponseScheme{Code: http.StatusOK}
+			response.Bytes.WriteString(handler(recorded.Body))
+
+			if structure != nil {
+				if err := json.Unmarshal(response.Bytes.Bytes(), structure); err != nil {
+					return response, err
+				}
+			}
+
+			return response, nil
+		})
+
+	return client
+}
+
+// cloneServiceMocked returns an ADF issue service, along with the sub-services used by the clones.
+func cloneServiceMocked(t *testing.T, client service.Connector) *IssueADFService {
+
+	attachment, err := NewIssueAttachmentService(client, "3")
+	assert.NoError(t, err)
+
+	commentADF, commentRT, err := NewCommentService(client, "3")
+	assert.NoError(t, err)
+
+	remote, err := NewRemoteLinkService(client, "3")
+	assert.NoError(t, err)
+
+	linkADF, linkRT, err := NewLinkService(client, "3", nil, remote)
+	assert.NoError(t, err)
+
+	metadata, err := NewMetadataService(client, "3")
+	assert.NoError(t, err)
+
+	searchADF, searchRT, err := NewSearchService(client, "3")
+	assert.NoError(t, err)
+
+	_, issueService, err := NewIssueService(client, "3", &IssueServices{
+		Attachment: attachment,
+		CommentADF: commentADF,
+		CommentRT:  commentRT,
+		LinkADF:    linkADF,
+		LinkRT:     linkRT,
+		Metadata:   metadata,
+		SearchADF:  searchADF,
+		SearchRT:   searchRT,
+	})
+	assert.NoError(t, err)
+
+	return issueService
+}
+
+func cloneResponsesMocked() map[string]func(body interface{}) string {
+
+	static := func(response string) func(body interface{}) string {
+		return func(interface{}) string { return response }
+	}
+
+	created := 0
+
+	return map[string]func(body interface{}) string{
+
+		"GET rest/api/3/issue/KP-1?expand=names": static(`{
+			"id": "10001", "key": "KP-1",
+			"names": {"customfield_10010": "Team Notes", "customfield_10011": "Severity", "customfield_10012": "Region", "customfield_10099": "Legacy"},
+			"fields": {
+				"project": {"id": "10000", "key": "KP"},
+				"issuetype": {"id": "10001", "name": "Story"},
+				"summary": "Template story",
+				"priority": {"self": "https://ctreminiom.atlassian.net/rest/api/3/priority/3", "id": "3", "name": "Medium"},
+				"assignee": {"accountId": "account-id-1", "displayName": "Carlos"},
+				"labels": [],
+				"customfield_10010": "Notes",
+				"customfield_10011": {"id": "100", "value": "High"},
+				"customfield_10012": {"id": "110", "value": "EMEA", "child": {"id": "111", "value": "Spain"}},
+				"customfield_10099": "Legacy value",
+				"status": {"id": "1", "name": "To Do"},
+				"attachment": [{"id": "500", "filename": "spec.txt"}],
+				"issuelinks": [
+					{"id": "100", "type": {"name": "Blocks"}, "outwardIssue": {"key": "KP-9"}},
+					{"id": "101", "type": {"name": "Relates"}, "inwardIssue": {"key": "KP-2"}}
+				]
+			}
+		}`),
+
+		"GET rest/api/3/issue/KP-2?expand=names": static(`{
+			"id": "10002", "key": "KP-2",
+			"fields": {
+				"project": {"id": "10000", "key": "KP"},
+				"issuetype": {"id": "10003", "name": "Sub-task"},
+				"parent": {"key": "KP-1"},
+				"summary": "Template sub-task",
+				"issuelinks": [{"id": "101", "type": {"name": "Relates"}, "outwardIssue": {"key": "KP-1"}}]
+			}
+		}`),
+
+		"GET rest/api/3/issue/createmeta/NP/issuetypes?maxResults=100&startAt=0": static(`{
+			"issueTypes": [{"id": "20001", "name": "Story"}, {"id": "20002", "name": "Sub-task"}], "total": 2
+		}`),
+
+		"GET rest/api/3/issue/createmeta/NP/issuetypes/20001?maxResults=100&startAt=0": static(`{
+			"fields": [
+				{"fieldId": "summary", "key": "summary", "name": "Summary"},
+				{"fieldId": "priority", "key": "priority", "name": "Priority"},
+				{"fieldId": "assignee", "key": "assignee", "name": "Assignee"},
+				{"fieldId": "labels", "key": "labels", "name": "Labels"},
+				{"fieldId": "customfield_20010", "key": "customfield_20010", "name": "Team Notes"},
+				{"fieldId": "customfield_20011", "key": "customfield_20011", "name": "Severity", "schema": {"type": "option"},
+					"allowedValues": [{"id": "200", "value": "Low"}, {"id": "201", "value": "High"}]},
+				{"fieldId": "customfield_20012", "key": "customfield_20012", "name": "Region", "schema": {"type": "option-with-child"},
+					"allowedValues": [{"id": "210", "value": "EMEA", "children": [{"id": "211", "value": "France"}]}]}
+			],
+			"total": 7
+		}`),
+
+		"GET rest/api/3/issue/createmeta/NP/issuetypes/20002?maxResults=100&startAt=0": static(`{
+			"fields": [
+				{"fieldId": "summary", "key": "summary", "name": "Summary"},
+				{"fieldId": "parent", "key": "parent", "name": "Parent"}
+			],
+			"total": 2
+		}`),
+
+		"POST rest/api/3/issue": func(interface{}) string {
+			created++
+			return fmt.Sprintf(`{"id": "2000%v", "key": "NP-%v"}`, created, created)
+		},
+
+		"POST rest/api/3/search/jql": func(body interface{}) string {
+			payload, _ := json.Marshal(body)
+			if gjson.GetBytes(payload, "jql").String() == `parent = "KP-1" ORDER BY created ASC` {
+				return `{"issues": [{"key": "KP-2"}]}`
+			}
+			return `{"issues": []}`
+		},
+
+		"GET rest/api/3/issue/KP-1/remotelink": static(`[{"id": 10000, "globalId": "system=http://www.mycompany.com/support&id=1",
+			"object": {"url": "http://www.mycompany.com/support?id=1", "title": "TSTSUP-111"}}]`),
+		"GET rest/api/3/issue/KP-2/remotelink": static(`[]`),
+
+		"GET rest/api/3/issue/KP-1/comment?maxResults=100&startAt=0": static(`{"comments": [
+			{"id": "10", "body": {"type": "doc", "version": 1, "content": []}, "visibility": {"type": "role", "value": "Administrators"}}
+		], "total": 1}`),
+		"GET rest/api/3/issue/KP-2/comment?maxResults=100&startAt=0": static(`{"comments": [], "total": 0}`),
+
+		"GET rest/api/3/attachment/content/500": static(`spec content`),
+
+		"POST rest/api/3/issue/NP-1/remotelink":  static(`{"id": 10001}`),
+		"POST rest/api/3/issue/NP-1/comment":     static(`{"id": "20"}`),
+		"POST rest/api/3/issue/NP-1/attachments": static(`[{"id": "600"}]`),
+		"POST rest/api/3/issueLink":              static(``),
+	}
+}
+
+func Test_cloneIssue(t *testing.T) {
+
+	options := func(dryRun bool) *model.IssueCloneOptionsScheme {
+
+		return &model.IssueCloneOptionsScheme{
+			ProjectKeyOrID: "NP",
+			SummaryPrefix:  "CLONE - ",
+			Children:       true,
+			Links:          true,
+			LinkType:       "Cloners",
+			RemoteLinks:    true,
+			Attachments:    true,
+			Comments:       true,
+			DryRun:         dryRun,
+			Mapper: func(ctx context.Context, source *model.IssueCloneSourceScheme, clone *model.IssueCloneScheme) error {
+				if source.Key == "KP-1" {
+					clone.Fields["labels"] = []string{"cloned"}
+				}
+				return nil
+			},
+		}
+	}
+
+	t.Run("when the issue is cloned", func(t *testing.T) {
+
+		var requests []*cloneRequestMocked
+		client := cloneConnectorMocked(t, cloneResponsesMocked(), &requests)
+
+		clone, _, err := cloneServiceMocked(t, client).Clone(context.Background(), "KP-1", options(false))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "NP-1", clone.Key)
+		assert.Equal(t, map[string]interface{}{
+			"project":           map[string]interface{}{"key": "NP"},
+			"issuetype":         map[string]interface{}{"id": "20001"},
+			"summary":           "CLONE - Template story",
+			"priority":          map[string]interface{}{"id": "3"},
+			"assignee":          map[string]interface{}{"accountId": "account-id-1"},
+			"labels":            []string{"cloned"},
+			"customfield_20010": "Notes",
+			"customfield_20011": map[string]interface{}{"id": "201"},
+		}, clone.Fields)
+		assert.Equal(t, []string{"customfield_10012", "customfield_10099"}, clone.DroppedFields)
+		assert.Equal(t, []string{"http://www.mycompany.com/support?id=1"}, clone.RemoteLinks)
+		assert.Equal(t, []string{"spec.txt"}, clone.Attachments)
+		assert.Equal(t, []string{"10"}, clone.Comments)
+
+		assert.Len(t, clone.Children, 1)
+		child := clone.Children[0]
+		assert.Equal(t, "NP-2", child.Key)
+		assert.Equal(t, map[string]interface{}{"key": "NP-1"}, child.Fields["parent"])
+		assert.Equal(t, "CLONE - Template sub-task", child.Fields["summary"])
+
+		assert.Equal(t, []*model.IssueCloneLinkScheme{
+			{Type: "Cloners", InwardIssue: "NP-1", OutwardIssue: "KP-1"},
+			{Type: "Blocks", InwardIssue: "NP-1", OutwardIssue: "KP-9"},
+			{Type: "Relates", InwardIssue: "NP-2", OutwardIssue: "NP-1"},
+		}, clone.Links)
+		assert.Equal(t, []*model.IssueCloneLinkScheme{{Type: "Cloners", InwardIssue: "NP-2", OutwardIssue: "KP-2"}}, child.Links)
+
+		var create, upload, comment *cloneRequestMocked
+		for _, request := range requests {
+			switch request.Endpoint {
+			case "rest/api/3/issue":
+				if create == nil {
+					create = request
+				}
+			case "rest/api/3/issue/NP-1/attachments":
+				upload = request
+			case "rest/api/3/issue/NP-1/comment":
+				comment = request
+			}
+		}
+
+		payload, err := json.Marshal(create.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "CLONE - Template story", gjson.GetBytes(payload, "fields.summary").String())
+		assert.Equal(t, "201", gjson.GetBytes(payload, "fields.customfield_20011.id").String())
+
+		assert.Contains(t, upload.ContentType, "multipart/form-data")
+		assert.Contains(t, upload.Body, `filename="spec.txt"`)
+		assert.Contains(t, upload.Body, "spec content")
+
+		payload, err = json.Marshal(comment.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"body": {"type": "doc", "version": 1}, "visibility": {"type": "role", "value": "Administrators"}}`,
+			string(payload))
+	})
+
+	t.Run("when the clone is a dry run", func(t *testing.T) {
+
+		var requests []*cloneRequestMocked
+		client := cloneConnectorMocked(t, cloneResponsesMocked(), &requests)
+
+		clone, _, err := cloneServiceMocked(t, client).Clone(context.Background(), "KP-1", options(true))
+		assert.NoError(t, err)
+
+		assert.Empty(t, clone.Key)
+		assert.Equal(t, map[string]interface{}{"key": "KP-1"}, clone.Children[0].Fields["parent"])
+		assert.Equal(t, []string{"spec.txt"}, clone.Attachments)
+		assert.Equal(t, &model.IssueCloneLinkScheme{Type: "Relates", InwardIssue: "KP-2", OutwardIssue: "KP-1"}, clone.Links[2])
+
+		for _, request := range requests {
+			if request.Method != http.MethodGet {
+				assert.Equal(t, "rest/api/3/search/jql", request.Endpoint)
+			}
+		}
+	})
+
+	t.Run("when the issue type is not available on the target project", func(t *testing.T) {
+
+		var requests []*cloneRequestMocked
+		responses := cloneResponsesMocked()
+		responses["GET rest/api/3/issue/createmeta/NP/issuetypes?maxResults=100&startAt=0"] = func(interface{}) string {
+			return `{"issueTypes": [{"id": "20003", "name": "Bug"}], "total": 1}`
+		}
+
+		_, _, err := cloneServiceMocked(t, cloneConnectorMocked(t, responses, &requests)).Clone(context.Background(), "KP-1", options(false))
+		assert.ErrorIs(t, err, model.ErrNoCloneIssueType)
+	})
+
+	t.Run("when the mapper fails", func(t *testing.T) {
+
+		var requests []*cloneRequestMocked
+		opts := options(false)
+		opts.Mapper = func(context.Context, *model.IssueCloneSourceScheme, *model.IssueCloneScheme) error {
+			return errors.New("error, the field cannot be mapped")
+		}
+
+		_, _, err := cloneServiceMocked(t, cloneConnectorMocked(t, cloneResponsesMocked(), &requests)).Clone(context.Background(), "KP-1", opts)
+		assert.EqualError(t, err, "error, the field cannot be mapped")
+	})
+
+	t.Run("when the issue key is not provided", func(t *testing.T) {
+
+		_, _, err := (&IssueADFService{}).Clone(context.Background(), "", nil)
+		assert.ErrorIs(t, err, model.ErrNoIssueKeyOrID)
+	})
+
+	t.Run("when the issue services are not set", func(t *testing.T) {
+
+		_, _, err := (&IssueADFService{}).Clone(context.Background(), "KP-1", nil)
+		assert.ErrorIs(t, err, model.ErrNoIssueCloneServices)
+	})
+}
+
+func Test_cloneFieldValue(t *testing.T) {
+
+	sprint := &model.IssueFieldSchemaScheme{Type: "array", Items: "json", Custom: "com.pyxis.greenhopper.jira:gh-sprint"}
+
+	assert.Nil(t, cloneFieldValue("summary", nil, ""))
+	assert.Nil(t, cloneFieldValue("labels", nil, []interface{}{}))
+	assert.Equal(t, map[string]interface{}{"id": "10", "child": map[string]interface{}{"id": "11"}},
+		cloneFieldValue("customfield_10001", nil, map[string]interface{}{"id": "10", "value": "A", "child": map[string]interface{}{"id": "11", "value": "B"}}))
+	assert.Equal(t, map[string]interface{}{"originalEstimate": "1d"},
+		cloneFieldValue("timetracking", nil, map[string]interface{}{"originalEstimate": "1d", "originalEstimateSeconds": 28800.0}))
+	assert.Equal(t, 2.0, cloneFieldValue("customfield_10020", sprint, []interface{}{
+		map[string]interface{}{"id": 1.0, "state": "closed"},
+		map[string]interface{}{"id": 2.0, "state": "active"},
+	}))
+	assert.Nil(t, cloneFieldValue("customfield_10020", sprint, []interface{}{map[string]interface{}{"id": 1.0, "state": "closed"}}))
+}
+
+func Test_cloneOptionValue(t *testing.T) {
+
+	allowed := []*cloneOption{
+		{ID: "200", Value: "Low"},
+		{ID: "210", Value: "EMEA", Children: []*cloneOption{{ID: "211", Value: "France"}}},
+	}
+
+	value, ok := cloneOptionValue(allowed, map[string]interface{}{"id": "100", "value": "Low"})
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"id": "200"}, value)
+
+	value, ok = cloneOptionValue(allowed, map[string]interface{}{"id": "110", "value": "EMEA", "child": map[string]interface{}{"id": "111", "value": "France"}})
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"id": "210", "child": map[string]interface{}{"id": "211"}}, value)
+
+	value, ok = cloneOptionValue(allowed, []interface{}{map[string]interface{}{"id": "100", "value": "Low"}})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "200"}}, value)
+
+	_, ok = cloneOptionValue(allowed, map[string]interface{}{"id": "101", "value": "High"})
+	assert.False(t, ok)
+
+	_, ok = cloneOptionValue(allowed, map[string]interface{}{"id": "110", "value": "EMEA", "child": map[string]interface{}{"id": "112", "value": "Spain"}})
+	assert.False(t, ok)
+}
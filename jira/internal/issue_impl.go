@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -48,6 +49,8 @@ type IssueServices struct {
 	WorklogRichText *WorklogRichTextService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Changelog is the service for fetching issue changelogs.
+	Changelog *ChangelogService
 }
 
 // NewIssueService creates new instances of IssueRichTextService and IssueADFService.
@@ -89,6 +92,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 		adfService.Watcher = services.Watcher
 		adfService.Worklog = services.WorklogAdf
 		adfService.Property = services.Property
+		adfService.Changelog = services.Changelog
 
 		richTextService.Comment = services.CommentRT
 		richTextService.Attachment = services.Attachment
@@ -104,6 +108,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 		richTextService.Watcher = services.Watcher
 		richTextService.Worklog = services.WorklogRichText
 		richTextService.Property = services.Property
+		richTextService.Changelog = services.Changelog
 
 	}
 
@@ -192,3 +197,310 @@ func getTransitions(ctx context.Context, client service.Connector, version, issu
 
 	return transitions, response, nil
 }
+
+// transitionMover performs a transition, requesting the screen fields when required.
+type transitionMover func(ctx context.Context, transition *model.IssueTransitionScheme) (*model.ResponseScheme, error)
+
+// transitionTo moves an issue to a status, performing the intermediate transitions found on the issue workflow
+// when the status cannot be reached with a single transition.
+func transitionTo(ctx context.Context, client service.Connector, version, issueKeyOrID, statusNameOrID string, maxSteps int,
+	move transitionMover) (*model.IssueTransitionPathScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	if statusNameOrID == "" {
+		return nil, nil, model.ErrNoStatusNameOrID
+	}
+
+	if maxSteps <= 0 {
+		maxSteps = 10
+	}
+
+	issue, response, err := getTransitionIssue(ctx, client, version, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	path := &model.IssueTransitionPathScheme{From: issue.Fields.Status, To: issue.Fields.Status}
+	if matchStatus(issue.Fields.Status, statusNameOrID) {
+		return path, response, nil
+	}
+
+	var graph *workflowGraph
+
+	for len(path.Steps) < maxSteps {
+
+		transitions, response, err := getTransitionsWithFields(ctx, client, version, issueKeyOrID)
+		if err != nil {
+			return path, response, err
+		}
+
+		transition := findTransitionToStatus(transitions.Transitions, statusNameOrID)
+		if transition == nil {
+
+			if graph == nil {
+
+				if graph, response, err = getWorkflowGraph(ctx, client, version, issue); err != nil {
+					return path, response, err
+				}
+			}
+
+			transition = graph.nextTransition(path.To, transitions.Transitions, statusNameOrID)
+			if transition == nil {
+				return path, response, fmt.Errorf("%w: %v", model.ErrNoTransitionPath, statusNameOrID)
+			}
+		}
+
+		if response, err = move(ctx, transition); err != nil {
+			return path, response, err
+		}
+
+		path.Steps = append(path.Steps, transition)
+		path.To = transition.To
+
+		if matchStatus(transition.To, statusNameOrID) {
+			return path, response, nil
+		}
+	}
+
+	return path, response, fmt.Errorf("%w: %v, the maximum number of steps was reached", model.ErrNoTransitionPath, statusNameOrID)
+}
+
+// transitionIssue contains the issue fields required to find the issue workflow.
+type transitionIssue struct {
+	Fields *struct {
+		Status    *model.StatusScheme    `json:"status,omitempty"`
+		Project   *model.ProjectScheme   `json:"project,omitempty"`
+		IssueType *model.IssueTypeScheme `json:"issuetype,omitempty"`
+	} `json:"fields,omitempty"`
+}
+
+func getTransitionIssue(ctx context.Context, client service.Connector, version, issueKeyOrID string) (*transitionIssue, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("fields", "status,project,issuetype")
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", version, issueKeyOrID, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(transitionIssue)
+	response, err := client.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if issue.Fields == nil || issue.Fields.Status == nil {
+		return nil, response, model.ErrNoIssueScheme
+	}
+
+	return issue, response, nil
+}
+
+func getTransitionsWithFields(ctx context.Context, client service.Connector, version, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("expand", "transitions.fields")
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions?%v", version, issueKeyOrID, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transitions := new(model.IssueTransitionsScheme)
+	response, err := client.Call(request, transitions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return transitions, response, nil
+}
+
+// workflowGraph represents the statuses and transitions of a workflow, indexed by status reference.
+type workflowGraph struct {
+	references map[string]string // The status references indexed by status ID.
+	statuses   map[string]*model.WorkflowStatusDetailScheme
+	edges      map[string][]*workflowEdge // The transitions indexed by source status reference.
+	global     []*workflowEdge            // The transitions available from any status.
+}
+
+type workflowEdge struct {
+	transitionID string
+	to           string
+}
+
+func getWorkflowGraph(ctx context.Context, client service.Connector, version string, issue *transitionIssue) (*workflowGraph, *model.ResponseScheme, error) {
+
+	if issue.Fields.Project == nil || issue.Fields.IssueType == nil {
+		return nil, nil, model.ErrNoTransitionPath
+	}
+
+	criteria := &model.WorkflowSearchCriteria{
+		ProjectAndIssueTypes: []*model.WorkflowSearchProjectIssueTypeMapping{
+			{ProjectID: issue.Fields.Project.ID, IssueTypeID: issue.Fields.IssueType.ID},
+		},
+	}
+
+	params := url.Values{}
+	params.Add("useTransitionLinksFormat", "true")
+
+	endpoint := fmt.Sprintf("rest/api/%v/workflows?%v", version, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodPost, endpoint, "", criteria)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflows := new(model.WorkflowReadResponseScheme)
+	response, err := client.Call(request, workflows)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(workflows.Workflows) == 0 {
+		return nil, response, model.ErrNoTransitionPath
+	}
+
+	return newWorkflowGraph(workflows.Statuses, workflows.Workflows[0]), response, nil
+}
+
+func newWorkflowGraph(statuses []*model.WorkflowStatusDetailScheme, workflow *model.JiraWorkflowScheme) *workflowGraph {
+
+	graph := &workflowGraph{
+		references: make(map[string]string),
+		statuses:   make(map[string]*model.WorkflowStatusDetailScheme),
+		edges:      make(map[string][]*workflowEdge),
+	}
+
+	for _, status := range statuses {
+
+		reference := status.StatusReference
+		if reference == "" {
+			reference = status.ID
+		}
+
+		graph.references[status.ID] = reference
+		graph.statuses[reference] = status
+	}
+
+	for _, transition := range workflow.Transitions {
+
+		if transition.Type == "INITIAL" {
+			continue
+		}
+
+		edge := &workflowEdge{transitionID: transition.ID, to: transition.ToStatusReference}
+		if edge.to == "" && transition.To != nil {
+			edge.to = transition.To.StatusReference
+		}
+
+		var sources []string
+		for _, link := range transition.Links {
+			sources = append(sources, link.FromStatusReference)
+		}
+
+		for _, from := range transition.From {
+			sources = append(sources, from.StatusReference)
+		}
+
+		if transition.Type == "GLOBAL" || len(sources) == 0 {
+			graph.global = append(graph.global, edge)
+			continue
+		}
+
+		for _, source := range sources {
+			graph.edges[source] = append(graph.edges[source], edge)
+		}
+	}
+
+	return graph
+}
+
+// nextTransition returns the available transition leading to the shortest workflow path to the status.
+func (g *workflowGraph) nextTransition(current *model.StatusScheme, available []*model.IssueTransitionScheme, statusNameOrID string) *model.IssueTransitionScheme {
+
+	start := current.ID
+	if reference, ok := g.references[current.ID]; ok {
+		start = reference
+	}
+
+	// The breadth-first search stores the first transition of the path used to reach each status.
+	firstTransition := map[string]string{start: ""}
+	queue := []string{start}
+
+	for len(queue) != 0 {
+
+		reference := queue[0]
+		queue = queue[1:]
+
+		if reference != start && g.matchStatus(reference, statusNameOrID) {
+			return findTransitionByID(available, firstTransition[reference])
+		}
+
+		for _, edge := range append(append([]*workflowEdge{}, g.edges[reference]...), g.global...) {
+
+			if _, visited := firstTransition[edge.to]; visited {
+				continue
+			}
+
+			first := firstTransition[reference]
+			if reference == start {
+
+				// The first transition must be available for the user, e.g. the conditions are met.
+				if findTransitionByID(available, edge.transitionID) == nil {
+					continue
+				}
+
+				first = edge.transitionID
+			}
+
+			firstTransition[edge.to] = first
+			queue = append(queue, edge.to)
+		}
+	}
+
+	return nil
+}
+
+func (g *workflowGraph) matchStatus(reference, statusNameOrID string) bool {
+
+	status, ok := g.statuses[reference]
+	if !ok {
+		return reference == statusNameOrID
+	}
+
+	return status.ID == statusNameOrID || strings.EqualFold(status.Name, statusNameOrID)
+}
+
+func findTransitionToStatus(transitions []*model.IssueTransitionScheme, statusNameOrID string) *model.IssueTransitionScheme {
+
+	for _, transition := range transitions {
+		if matchStatus(transition.To, statusNameOrID) {
+			return transition
+		}
+	}
+
+	return nil
+}
+
+func findTransitionByID(transitions []*model.IssueTransitionScheme, transitionID string) *model.IssueTransitionScheme {
+
+	for _, transition := range transitions {
+		if transition.ID == transitionID {
+			return transition
+		}
+	}
+
+	return nil
+}
+
+func matchStatus(status *model.StatusScheme, statusNameOrID string) bool {
+	return status != nil && (status.ID == statusNameOrID || strings.EqualFold(status.Name, statusNameOrID))
+}
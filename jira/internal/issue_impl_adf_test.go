@@ -1698,3 +1698,46 @@ func Test_internalIssueADFServiceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalIssueADFServiceImpl_TransitionTo(t *testing.T) {
+
+	var moves []interface{}
+	client := transitionToConnectorMocked(t, "3", &moves)
+
+	_, issueService, err := NewIssueService(client, "3", nil)
+	assert.NoError(t, err)
+
+	var screens []string
+	options := &model.IssueTransitionToOptionsV3{
+		Fields: func(ctx context.Context, issueKeyOrID string, transition *model.IssueTransitionScheme) (*model.IssueMoveOptionsV3, error) {
+
+			screens = append(screens, transition.ID)
+
+			customFields := &model.CustomFields{}
+			if err := customFields.Raw("resolution", map[string]interface{}{"name": "Fixed"}); err != nil {
+				return nil, err
+			}
+
+			return &model.IssueMoveOptionsV3{Fields: &model.IssueScheme{}, CustomFields: customFields}, nil
+		},
+	}
+
+	path, response, err := issueService.TransitionTo(context.Background(), "KP-1", "done", options)
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, "To Do", path.From.Name)
+	assert.Equal(t, "Done", path.To.Name)
+	assert.Len(t, path.Steps, 2)
+	assert.Equal(t, "11", path.Steps[0].ID)
+	assert.Equal(t, "21", path.Steps[1].ID)
+	assert.Equal(t, []string{"21"}, screens)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"transition": map[string]interface{}{"id": "11"}},
+		map[string]interface{}{
+			"transition": map[string]interface{}{"id": "21"},
+			"fields":     map[string]interface{}{"resolution": map[string]interface{}{"name": "Fixed"}},
+		},
+	}, moves)
+}
@@ -46,6 +46,8 @@ type IssueRichTextService struct {
 	Worklog *WorklogRichTextService
 	// Property is the service for managing issue properties.
 	Property *IssuePropertyService
+	// Changelog is the service for fetching issue changelogs.
+	Changelog *ChangelogService
 }
 
 // Delete deletes an issue.
@@ -164,6 +166,33 @@ func (i IssueRichTextService) Move(ctx context.Context, issueKeyOrID, transition
 	return i.internalClient.Move(ctx, issueKeyOrID, transitionID, options)
 }
 
+// TransitionTo moves an issue to a status, using its name or ID.
+//
+// When the status cannot be reached with a single transition, the shortest path is found using the issue workflow
+// and the intermediate transitions are performed.
+//
+// The fields of the transition screens are requested using the options callback.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+func (i *IssueRichTextService) TransitionTo(ctx context.Context, issueKeyOrID, statusNameOrID string, options *model.IssueTransitionToOptionsV2) (*model.IssueTransitionPathScheme, *model.ResponseScheme, error) {
+	return i.internalClient.TransitionTo(ctx, issueKeyOrID, statusNameOrID, options)
+}
+
+// Clone clones an issue, along with its children, links, remote links, attachments and comments
+// when they're selected on the options.
+//
+// The fields are copied when they're available on the create screen of the target project,
+// the custom fields and issue types are matched by name when their IDs are different.
+//
+// The dry run mode describes the issues and the items to be created without creating them.
+//
+// The clone uses the Attachment, Comment, Link, Metadata and Search sub-services.
+//
+// POST /rest/api/{2-3}/issue
+func (i *IssueRichTextService) Clone(ctx context.Context, issueKeyOrID string, options *model.IssueCloneOptionsScheme) (*model.IssueCloneScheme, *model.ResponseScheme, error) {
+	return cloneIssue(ctx, i.cloneServices(), issueKeyOrID, options)
+}
+
 type internalRichTextServiceImpl struct {
 	c       service.Connector
 	version string
@@ -402,3 +431,26 @@ func (i *internalRichTextServiceImpl) Move(ctx context.Context, issueKeyOrID, tr
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalRichTextServiceImpl) TransitionTo(ctx context.Context, issueKeyOrID, statusNameOrID string, options *model.IssueTransitionToOptionsV2) (*model.IssueTransitionPathScheme, *model.ResponseScheme, error) {
+
+	if options == nil {
+		options = &model.IssueTransitionToOptionsV2{}
+	}
+
+	move := func(ctx context.Context, transition *model.IssueTransitionScheme) (*model.ResponseScheme, error) {
+
+		var moveOptions *model.IssueMoveOptionsV2
+		if options.Fields != nil && transition.RequiresFields() {
+
+			var err error
+			if moveOptions, err = options.Fields(ctx, issueKeyOrID, transition); err != nil {
+				return nil, err
+			}
+		}
+
+		return i.Move(ctx, issueKeyOrID, transition.ID, moveOptions)
+	}
+
+	return transitionTo(ctx, i.c, i.version, issueKeyOrID, statusNameOrID, options.MaxSteps, move)
+}
@@ -1698,3 +1698,24 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRichTextServiceImpl_TransitionTo(t *testing.T) {
+
+	var moves []interface{}
+	client := transitionToConnectorMocked(t, "2", &moves)
+
+	issueService, _, err := NewIssueService(client, "2", nil)
+	assert.NoError(t, err)
+
+	options := &model.IssueTransitionToOptionsV2{
+		Fields: func(ctx context.Context, issueKeyOrID string, transition *model.IssueTransitionScheme) (*model.IssueMoveOptionsV2, error) {
+			return nil, errors.New("the resolution is required")
+		},
+	}
+
+	path, _, err := issueService.TransitionTo(context.Background(), "KP-1", "3", options)
+	assert.EqualError(t, err, "the resolution is required")
+	assert.Len(t, path.Steps, 1)
+	assert.Equal(t, "In Progress", path.To.Name)
+	assert.Len(t, moves, 1)
+}
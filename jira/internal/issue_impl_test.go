@@ -1,9 +1,14 @@
 package internal
 
 import (
+	"context"
+	"encoding/json"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
 	"testing"
 )
 
@@ -60,3 +65,146 @@ func TestNewIssueService(t *testing.T) {
 		})
 	}
 }
+
+// transitionToConnectorMocked mocks an issue on the "To Do" status, with a workflow moving the issue
+// to "In Progress" and then to "Done". The payloads of the transitions performed are appended to moves.
+// The calls made after the first transition are optional, so the mock can be used when no transition is performed.
+func transitionToConnectorMocked(t *testing.T, version string, moves *[]interface{}) service.Connector {
+
+	client := mocks.NewConnector(t)
+
+	issueRequest, _ := http.NewRequest(http.MethodGet, "issue", nil)
+	transitionsRequest, _ := http.NewRequest(http.MethodGet, "transitions", nil)
+	workflowRequest, _ := http.NewRequest(http.MethodPost, "workflows", nil)
+	moveRequest, _ := http.NewRequest(http.MethodPost, "move", nil)
+
+	client.On("NewRequest", context.Background(), http.MethodGet,
+		"rest/api/"+version+"/issue/KP-1?fields=status%2Cproject%2Cissuetype", "", nil).
+		Return(issueRequest, nil)
+
+	client.On("Call", issueRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			issue := args.Get(1).(*transitionIssue)
+			issue.Fields = &struct {
+				Status    *model.StatusScheme    `json:"status,omitempty"`
+				Project   *model.ProjectScheme   `json:"project,omitempty"`
+				IssueType *model.IssueTypeScheme `json:"issuetype,omitempty"`
+			}{
+				Status:    &model.StatusScheme{ID: "1", Name: "To Do"},
+				Project:   &model.ProjectScheme{ID: "10000"},
+				IssueType: &model.IssueTypeScheme{ID: "10001"},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("NewRequest", context.Background(), http.MethodGet,
+		"rest/api/"+version+"/issue/KP-1/transitions?expand=transitions.fields", "", nil).
+		Return(transitionsRequest, nil)
+
+	client.On("Call", transitionsRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.IssueTransitionsScheme).Transitions = []*model.IssueTransitionScheme{
+				{ID: "11", Name: "Start", To: &model.StatusScheme{ID: "2", Name: "In Progress"}},
+				{ID: "31", Name: "Reject", To: &model.StatusScheme{ID: "4", Name: "Rejected"}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil).Once()
+
+	client.On("Call", transitionsRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.IssueTransitionsScheme).Transitions = []*model.IssueTransitionScheme{
+				{ID: "21", Name: "Resolve", To: &model.StatusScheme{ID: "3", Name: "Done"}, HasScreen: true,
+					Fields: map[string]*model.IssueTransitionFieldScheme{"resolution": {Required: true, Name: "Resolution"}}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil).Maybe()
+
+	client.On("NewRequest", context.Background(), http.MethodPost,
+		"rest/api/"+version+"/workflows?useTransitionLinksFormat=true", "",
+		&model.WorkflowSearchCriteria{ProjectAndIssueTypes: []*model.WorkflowSearchProjectIssueTypeMapping{
+			{ProjectID: "10000", IssueTypeID: "10001"},
+		}}).
+		Return(workflowRequest, nil)
+
+	client.On("Call", workflowRequest, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*model.WorkflowReadResponseScheme) = model.WorkflowReadResponseScheme{
+				Statuses: []*model.WorkflowStatusDetailScheme{
+					{ID: "1", Name: "To Do", StatusReference: "ref-1"},
+					{ID: "2", Name: "In Progress", StatusReference: "ref-2"},
+					{ID: "3", Name: "Done", StatusReference: "ref-3"},
+					{ID: "4", Name: "Rejected", StatusReference: "ref-4"},
+				},
+				Workflows: []*model.JiraWorkflowScheme{{
+					Transitions: []*model.WorkflowTransitionScheme{
+						{ID: "1", Type: "INITIAL", ToStatusReference: "ref-1"},
+						{ID: "11", Type: "DIRECTED", ToStatusReference: "ref-2",
+							Links: []*model.WorkflowTransitionLinkScheme{{FromStatusReference: "ref-1"}}},
+						{ID: "21", Type: "DIRECTED", ToStatusReference: "ref-3",
+							Links: []*model.WorkflowTransitionLinkScheme{{FromStatusReference: "ref-2"}}},
+						{ID: "31", Type: "GLOBAL", ToStatusReference: "ref-4"},
+					},
+				}},
+			}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("NewRequest", context.Background(), http.MethodPost,
+		"rest/api/"+version+"/issue/KP-1/transitions", "", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*moves = append(*moves, args.Get(4))
+		}).
+		Return(moveRequest, nil).Maybe()
+
+	client.On("Call", moveRequest, nil).
+		Return(&model.ResponseScheme{}, nil).Maybe()
+
+	return client
+}
+
+func Test_transitionTo(t *testing.T) {
+
+	t.Run("when the status is already the current one", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		request, _ := http.NewRequest(http.MethodGet, "issue", nil)
+		client.On("NewRequest", context.Background(), http.MethodGet,
+			"rest/api/3/issue/KP-1?fields=status%2Cproject%2Cissuetype", "", nil).
+			Return(request, nil)
+
+		client.On("Call", request, mock.Anything).
+			Run(func(args mock.Arguments) {
+				assert.NoError(t, json.Unmarshal([]byte(`{"fields":{"status":{"id":"3","name":"Done"}}}`), args.Get(1)))
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		path, _, err := transitionTo(context.Background(), client, "3", "KP-1", "done", 0, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, path.Steps)
+	})
+
+	t.Run("when the status cannot be reached", func(t *testing.T) {
+
+		var moves []interface{}
+		client := transitionToConnectorMocked(t, "3", &moves)
+
+		move := func(ctx context.Context, transition *model.IssueTransitionScheme) (*model.ResponseScheme, error) {
+			t.Fatalf("unexpected transition %v", transition.ID)
+			return nil, nil
+		}
+
+		_, _, err := transitionTo(context.Background(), client, "3", "KP-1", "Archived", 0, move)
+		assert.ErrorIs(t, err, model.ErrNoTransitionPath)
+		assert.Empty(t, moves)
+	})
+
+	t.Run("when the parameters are not provided", func(t *testing.T) {
+
+		_, _, err := transitionTo(context.Background(), nil, "3", "", "Done", 0, nil)
+		assert.ErrorIs(t, err, model.ErrNoIssueKeyOrID)
+
+		_, _, err = transitionTo(context.Background(), nil, "3", "KP-1", "", 0, nil)
+		assert.ErrorIs(t, err, model.ErrNoStatusNameOrID)
+	})
+}
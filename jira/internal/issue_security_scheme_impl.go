@@ -0,0 +1,561 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewIssueSecuritySchemeService creates a new instance of IssueSecuritySchemeService.
+func NewIssueSecuritySchemeService(client service.Connector, version string) (*IssueSecuritySchemeService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &IssueSecuritySchemeService{
+		internalClient: &internalIssueSecuritySchemeImpl{c: client, version: version},
+	}, nil
+}
+
+// IssueSecuritySchemeService provides methods to manage the issue security schemes, security levels and level members in Jira.
+type IssueSecuritySchemeService struct {
+	// internalClient is the connector interface for issue security scheme operations.
+	internalClient jira.IssueSecuritySchemeConnector
+}
+
+// Gets returns all issue security schemes.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes
+func (i *IssueSecuritySchemeService) Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Gets(ctx)
+}
+
+// Get returns an issue security scheme along with its security levels.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Get(ctx, schemeID)
+}
+
+// Search returns a paginated list of issue security schemes, along with the projects using them.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/search
+func (i *IssueSecuritySchemeService) Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemePageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Search(ctx, options, startAt, maxResults)
+}
+
+// Create creates an issue security scheme, along with its security levels and members.
+//
+// POST /rest/api/{2-3}/issuesecurityschemes
+func (i *IssueSecuritySchemeService) Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Create(ctx, payload)
+}
+
+// Update updates the name and description of an issue security scheme.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Update(ctx context.Context, schemeID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.Update(ctx, schemeID, payload)
+}
+
+// Delete deletes an issue security scheme.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+func (i *IssueSecuritySchemeService) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+	return i.internalClient.Delete(ctx, schemeID)
+}
+
+// Level returns a security level.
+//
+// GET /rest/api/{2-3}/securitylevel/{levelID}
+func (i *IssueSecuritySchemeService) Level(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Level(ctx, levelID)
+}
+
+// Levels returns a paginated list of security levels, filtered by ID and scheme.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level
+func (i *IssueSecuritySchemeService) Levels(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Levels(ctx, options, startAt, maxResults)
+}
+
+// AddLevels adds security levels, along with their members, to an issue security scheme.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level
+func (i *IssueSecuritySchemeService) AddLevels(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.AddLevels(ctx, schemeID, payload)
+}
+
+// UpdateLevel updates the name and description of a security level.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+func (i *IssueSecuritySchemeService) UpdateLevel(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.UpdateLevel(ctx, schemeID, levelID, payload)
+}
+
+// DeleteLevel deletes a security level, the issues using it are moved to the replaceWith level when it's provided.
+//
+// The deletion is asynchronous, use the task service to follow its progress.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+func (i *IssueSecuritySchemeService) DeleteLevel(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.DeleteLevel(ctx, schemeID, levelID, replaceWith)
+}
+
+// SetDefaultLevels sets the default security level of issue security schemes.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/level/default
+func (i *IssueSecuritySchemeService) SetDefaultLevels(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.SetDefaultLevels(ctx, payload)
+}
+
+// Members returns a paginated list of security level members, filtered by ID, scheme and level.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+func (i *IssueSecuritySchemeService) Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Members(ctx, options, startAt, maxResults)
+}
+
+// AddMembers adds members to a security level.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member
+func (i *IssueSecuritySchemeService) AddMembers(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error) {
+	return i.internalClient.AddMembers(ctx, schemeID, levelID, payload)
+}
+
+// RemoveMember removes a member from a security level.
+//
+// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member/{memberID}
+func (i *IssueSecuritySchemeService) RemoveMember(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error) {
+	return i.internalClient.RemoveMember(ctx, schemeID, levelID, memberID)
+}
+
+// Projects returns a paginated list of the projects associated with issue security schemes.
+//
+// GET /rest/api/{2-3}/issuesecurityschemes/project
+func (i *IssueSecuritySchemeService) Projects(ctx context.Context, options *model.IssueSecuritySchemeAssociationSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeAssociationPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Projects(ctx, options, startAt, maxResults)
+}
+
+// Associate associates an issue security scheme with a project, the security levels of the issues are remapped.
+//
+// The association is asynchronous, use the task service to follow its progress.
+//
+// PUT /rest/api/{2-3}/issuesecurityschemes/project
+func (i *IssueSecuritySchemeService) Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Associate(ctx, payload)
+}
+
+type internalIssueSecuritySchemeImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalIssueSecuritySchemeImpl) Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemes := new(model.IssueSecuritySchemesScheme)
+	response, err := i.c.Call(request, schemes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return schemes, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.IssueSecuritySchemeScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemePageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, projectID := range options.ProjectIDs {
+			params.Add("projectId", projectID)
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/search?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecuritySchemePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.IssueSecuritySchemeCreatedScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Update(ctx context.Context, schemeID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Level(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error) {
+
+	if levelID == "" {
+		return nil, nil, model.ErrNoIssueSecurityLevelID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/securitylevel/%v", i.version, levelID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(model.IssueSecurityLevelScheme)
+	response, err := i.c.Call(request, level)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return level, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Levels(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("schemeId", schemeID)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) AddLevels(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) UpdateLevel(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	if levelID == "" {
+		return nil, model.ErrNoIssueSecurityLevelID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v", i.version, schemeID, levelID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) DeleteLevel(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	if levelID == "" {
+		return nil, nil, model.ErrNoIssueSecurityLevelID
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v", i.version, schemeID, levelID))
+
+	if replaceWith != "" {
+
+		params := url.Values{}
+		params.Add("replaceWith", replaceWith)
+
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) SetDefaultLevels(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level/default", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, id := range options.IDs {
+			params.Add("id", id)
+		}
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("schemeId", schemeID)
+		}
+
+		for _, levelID := range options.LevelIDs {
+			params.Add("levelId", levelID)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/level/member?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecurityLevelMemberPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) AddMembers(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	if levelID == "" {
+		return nil, model.ErrNoIssueSecurityLevelID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v/member", i.version, schemeID, levelID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) RemoveMember(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoIssueSecuritySchemeID
+	}
+
+	if levelID == "" {
+		return nil, model.ErrNoIssueSecurityLevelID
+	}
+
+	if memberID == "" {
+		return nil, model.ErrNoIssueSecurityMemberID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/%v/level/%v/member/%v", i.version, schemeID, levelID, memberID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalIssueSecuritySchemeImpl) Projects(ctx context.Context, options *model.IssueSecuritySchemeAssociationSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeAssociationPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("issueSecuritySchemeId", schemeID)
+		}
+
+		for _, projectID := range options.ProjectIDs {
+			params.Add("projectId", projectID)
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/project?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueSecuritySchemeAssociationPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalIssueSecuritySchemeImpl) Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/issuesecurityschemes/project", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.TaskScheme)
+	response, err := i.c.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
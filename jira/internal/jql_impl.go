@@ -40,6 +40,36 @@ func (j *JQLService) Parse(ctx context.Context, validationType string, JqlQuerie
 	return j.internalClient.Parse(ctx, validationType, JqlQueries)
 }
 
+// Autocomplete returns the fields, functions and reserved words used to build the JQL queries.
+//
+// The payload filters the fields by project and is sent as a POST request, the fields of all the projects are returned when it's nil.
+//
+// GET /rest/api/{2-3}/jql/autocompletedata
+func (j *JQLService) Autocomplete(ctx context.Context, payload *model.JQLReferenceDataPayloadScheme) (*model.JQLReferenceDataScheme, *model.ResponseScheme, error) {
+	return j.internalClient.Autocomplete(ctx, payload)
+}
+
+// Suggestions returns the suggested values of a field or of a CHANGED operator predicate, for the text typed by the user.
+//
+// GET /rest/api/{2-3}/jql/autocompletedata/suggestions
+func (j *JQLService) Suggestions(ctx context.Context, options *model.JQLSuggestionOptionsScheme) (*model.JQLSuggestionPageScheme, *model.ResponseScheme, error) {
+	return j.internalClient.Suggestions(ctx, options)
+}
+
+// Sanitize sanitizes JQL queries, the entities the user can't view are replaced by their IDs.
+//
+// POST /rest/api/{2-3}/jql/sanitize
+func (j *JQLService) Sanitize(ctx context.Context, payload *model.JQLSanitizePayloadScheme) (*model.JQLSanitizedPageScheme, *model.ResponseScheme, error) {
+	return j.internalClient.Sanitize(ctx, payload)
+}
+
+// Convert converts the user names and user keys of JQL queries to account IDs, e.g. to migrate the queries of the saved filters.
+//
+// POST /rest/api/{2-3}/jql/pdcleaner
+func (j *JQLService) Convert(ctx context.Context, queries []string) (*model.JQLPersonalDataMigrationScheme, *model.ResponseScheme, error) {
+	return j.internalClient.Convert(ctx, queries)
+}
+
 type internalJQLServiceImpl struct {
 	c       service.Connector
 	version string
@@ -70,3 +100,113 @@ func (i *internalJQLServiceImpl) Parse(ctx context.Context, validationType strin
 
 	return page, response, nil
 }
+
+func (i *internalJQLServiceImpl) Autocomplete(ctx context.Context, payload *model.JQLReferenceDataPayloadScheme) (*model.JQLReferenceDataScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/jql/autocompletedata", i.version)
+
+	method := http.MethodGet
+	if payload != nil {
+		method = http.MethodPost
+	}
+
+	request, err := i.c.NewRequest(ctx, method, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(model.JQLReferenceDataScheme)
+	response, err := i.c.Call(request, data)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return data, response, nil
+}
+
+func (i *internalJQLServiceImpl) Suggestions(ctx context.Context, options *model.JQLSuggestionOptionsScheme) (*model.JQLSuggestionPageScheme, *model.ResponseScheme, error) {
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/jql/autocompletedata/suggestions", i.version))
+
+	if options != nil {
+
+		params := url.Values{}
+		if options.FieldName != "" {
+			params.Add("fieldName", options.FieldName)
+		}
+
+		if options.FieldValue != "" {
+			params.Add("fieldValue", options.FieldValue)
+		}
+
+		if options.PredicateName != "" {
+			params.Add("predicateName", options.PredicateName)
+		}
+
+		if options.PredicateValue != "" {
+			params.Add("predicateValue", options.PredicateValue)
+		}
+
+		if len(params) != 0 {
+			endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+		}
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suggestions := new(model.JQLSuggestionPageScheme)
+	response, err := i.c.Call(request, suggestions)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return suggestions, response, nil
+}
+
+func (i *internalJQLServiceImpl) Sanitize(ctx context.Context, payload *model.JQLSanitizePayloadScheme) (*model.JQLSanitizedPageScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.Queries) == 0 {
+		return nil, nil, model.ErrNoQuery
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/jql/sanitize", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queries := new(model.JQLSanitizedPageScheme)
+	response, err := i.c.Call(request, queries)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return queries, response, nil
+}
+
+func (i *internalJQLServiceImpl) Convert(ctx context.Context, queries []string) (*model.JQLPersonalDataMigrationScheme, *model.ResponseScheme, error) {
+
+	if len(queries) == 0 {
+		return nil, nil, model.ErrNoQuery
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/jql/pdcleaner", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", map[string]interface{}{"queryStrings": queries})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	converted := new(model.JQLPersonalDataMigrationScheme)
+	response, err := i.c.Call(request, converted)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return converted, response, nil
+}
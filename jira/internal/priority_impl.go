@@ -11,7 +11,7 @@ import (
 )
 
 // NewPriorityService creates a new instance of PriorityService.
-func NewPriorityService(client service.Connector, version string) (*PriorityService, error) {
+func NewPriorityService(client service.Connector, version string, scheme *PrioritySchemeService) (*PriorityService, error) {
 
 	if version == "" {
 		return nil, model.ErrNoVersionProvided
@@ -19,6 +19,7 @@ func NewPriorityService(client service.Connector, version string) (*PriorityServ
 
 	return &PriorityService{
 		internalClient: &internalPriorityImpl{c: client, version: version},
+		Scheme:         scheme,
 	}, nil
 }
 
@@ -26,6 +27,8 @@ func NewPriorityService(client service.Connector, version string) (*PriorityServ
 type PriorityService struct {
 	// internalClient is the connector interface for priority operations.
 	internalClient jira.PriorityConnector
+	// Scheme is the service for managing priority schemes.
+	Scheme *PrioritySchemeService
 }
 
 // Gets returns the list of all issue priorities.
@@ -120,7 +120,7 @@ func Test_internalPriorityImpl_Gets(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			priorityService, err := NewPriorityService(testCase.fields.c, testCase.fields.version)
+			priorityService, err := NewPriorityService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := priorityService.Gets(testCase.args.ctx)
@@ -264,7 +264,7 @@ func Test_internalPriorityImpl_Get(t *testing.T) {
 				testCase.on(&testCase.fields)
 			}
 
-			priorityService, err := NewPriorityService(testCase.fields.c, testCase.fields.version)
+			priorityService, err := NewPriorityService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
 			gotResult, gotResponse, err := priorityService.Get(testCase.args.ctx, testCase.args.priorityID)
@@ -322,7 +322,7 @@ func Test_NewPriorityService(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			got, err := NewPriorityService(testCase.args.client, testCase.args.version)
+			got, err := NewPriorityService(testCase.args.client, testCase.args.version, nil)
 
 			if testCase.wantErr {
 
@@ -0,0 +1,311 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewPrioritySchemeService creates a new instance of PrioritySchemeService.
+func NewPrioritySchemeService(client service.Connector, version string) (*PrioritySchemeService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &PrioritySchemeService{
+		internalClient: &internalPrioritySchemeImpl{c: client, version: version},
+	}, nil
+}
+
+// PrioritySchemeService provides methods to manage the priority schemes in Jira.
+type PrioritySchemeService struct {
+	// internalClient is the connector interface for priority scheme operations.
+	internalClient jira.PrioritySchemeConnector
+}
+
+// Gets returns a paginated list of priority schemes.
+//
+// GET /rest/api/{2-3}/priorityscheme
+func (p *PrioritySchemeService) Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Gets(ctx, options, startAt, maxResults)
+}
+
+// Create creates a priority scheme.
+//
+// The priorities of the issues of the projects are remapped asynchronously when needed, use the task service to follow the task returned.
+//
+// POST /rest/api/{2-3}/priorityscheme
+func (p *PrioritySchemeService) Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Create(ctx, payload)
+}
+
+// Update updates a priority scheme, including its priorities and projects.
+//
+// The priorities of the issues of the projects are remapped asynchronously when needed, use the task service to follow the task returned.
+//
+// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Update(ctx, schemeID, payload)
+}
+
+// Delete deletes a priority scheme, the scheme must not be used by any project.
+//
+// DELETE /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+	return p.internalClient.Delete(ctx, schemeID)
+}
+
+// Priorities returns a paginated list of the priorities of a priority scheme.
+//
+// GET /rest/api/{2-3}/priorityscheme/{schemeID}/priorities
+func (p *PrioritySchemeService) Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityWithSequencePageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Priorities(ctx, schemeID, startAt, maxResults)
+}
+
+// Projects returns a paginated list of the projects using a priority scheme.
+//
+// GET /rest/api/{2-3}/priorityscheme/{schemeID}/projects
+func (p *PrioritySchemeService) Projects(ctx context.Context, schemeID string, options *model.PrioritySchemeProjectSearchOptions, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Projects(ctx, schemeID, options, startAt, maxResults)
+}
+
+// Associate associates projects with a priority scheme, the mappings set the new priorities of the issues of the projects.
+//
+// The priorities of the issues are remapped asynchronously when needed, use the task service to follow the task returned.
+//
+// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Associate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Associate(ctx, schemeID, projectIDs, mappings)
+}
+
+// Disassociate disassociates projects from a priority scheme, the projects use the default priority scheme.
+//
+// The mappings set the new priorities of the issues of the projects, they are remapped asynchronously when needed.
+//
+// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+func (p *PrioritySchemeService) Disassociate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+	return p.internalClient.Disassociate(ctx, schemeID, projectIDs, mappings)
+}
+
+type internalPrioritySchemeImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalPrioritySchemeImpl) Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, priorityID := range options.PriorityIDs {
+			params.Add("priorityId", priorityID)
+		}
+
+		for _, schemeID := range options.SchemeIDs {
+			params.Add("schemeId", schemeID)
+		}
+
+		if options.SchemeName != "" {
+			params.Add("schemeName", options.SchemeName)
+		}
+
+		if options.OnlyDefault {
+			params.Add("onlyDefault", "true")
+		}
+
+		if options.OrderBy != "" {
+			params.Add("orderBy", options.OrderBy)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PrioritySchemePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(model.PrioritySchemeCreatedScheme)
+	response, err := i.c.Call(request, scheme)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return scheme, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoPrioritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.PrioritySchemeUpdatedScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, model.ErrNoPrioritySchemeID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v", i.version, schemeID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPrioritySchemeImpl) Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityWithSequencePageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoPrioritySchemeID
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v/priorities?%v", i.version, schemeID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PriorityWithSequencePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Projects(ctx context.Context, schemeID string, options *model.PrioritySchemeProjectSearchOptions, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoPrioritySchemeID
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, projectID := range options.ProjectIDs {
+			params.Add("projectId", projectID)
+		}
+
+		if options.Query != "" {
+			params.Add("query", options.Query)
+		}
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/priorityscheme/%v/projects?%v", i.version, schemeID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PrioritySchemeProjectPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPrioritySchemeImpl) Associate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoPrioritySchemeID
+	}
+
+	if len(projectIDs) == 0 {
+		return nil, nil, model.ErrNoProjectIDs
+	}
+
+	payload := &model.PrioritySchemeUpdatePayloadScheme{
+		Projects: &model.PrioritySchemeChangesPayloadScheme{Add: &model.PrioritySchemeIDsPayloadScheme{IDs: projectIDs}},
+	}
+
+	if len(mappings) != 0 {
+		payload.Mappings = &model.PriorityMappingScheme{In: mappings}
+	}
+
+	return i.Update(ctx, schemeID, payload)
+}
+
+func (i *internalPrioritySchemeImpl) Disassociate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error) {
+
+	if schemeID == "" {
+		return nil, nil, model.ErrNoPrioritySchemeID
+	}
+
+	if len(projectIDs) == 0 {
+		return nil, nil, model.ErrNoProjectIDs
+	}
+
+	payload := &model.PrioritySchemeUpdatePayloadScheme{
+		Projects: &model.PrioritySchemeChangesPayloadScheme{Remove: &model.PrioritySchemeIDsPayloadScheme{IDs: projectIDs}},
+	}
+
+	if len(mappings) != 0 {
+		payload.Mappings = &model.PriorityMappingScheme{Out: mappings}
+	}
+
+	return i.Update(ctx, schemeID, payload)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -52,6 +53,47 @@ func (t *TaskService) Cancel(ctx context.Context, taskID string) (*model.Respons
 	return t.internalClient.Cancel(ctx, taskID)
 }
 
+// Wait polls a task until it reaches a final status, e.g. COMPLETE or FAILED.
+//
+// The interval is the time between two task requests, 5 seconds when zero. Use the context to set a deadline,
+// the last task fetched is returned along with the context error when it's done.
+func (t *TaskService) Wait(ctx context.Context, taskID string, interval time.Duration) (*model.TaskScheme, *model.ResponseScheme, error) {
+
+	return waitTask(ctx, interval, func(ctx context.Context) (*model.TaskScheme, *model.ResponseScheme, error) {
+		return t.internalClient.Get(ctx, taskID)
+	})
+}
+
+// waitTask polls a task with the get function until it reaches a final status or the context is done.
+func waitTask[T interface{ IsFinished() bool }](ctx context.Context, interval time.Duration, get func(ctx context.Context) (T, *model.ResponseScheme, error)) (T, *model.ResponseScheme, error) {
+
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		task, response, err := get(ctx)
+		if err != nil {
+			var none T
+			return none, response, err
+		}
+
+		if task.IsFinished() {
+			return task, response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return task, response, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 type internalTaskServiceImpl struct {
 	c       service.Connector
 	version string
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -300,6 +302,69 @@ func Test_internalTaskServiceImpl_Cancel(t *testing.T) {
 	}
 }
 
+func TestTaskService_Wait(t *testing.T) {
+
+	t.Run("when the task finishes", func(t *testing.T) {
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", context.Background(), http.MethodGet, "rest/api/3/task/10000", "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.TaskScheme).Status = model.BulkTaskStatusRunning
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*model.TaskScheme) = model.TaskScheme{ID: "10000", Status: model.BulkTaskStatusComplete, Progress: 100}
+			}).
+			Return(&model.ResponseScheme{}, nil).Once()
+
+		taskService, err := NewTaskService(client, "3")
+		assert.NoError(t, err)
+
+		task, _, err := taskService.Wait(context.Background(), "10000", time.Millisecond)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, task.Progress)
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		client := mocks.NewConnector(t)
+
+		client.On("NewRequest", ctx, http.MethodGet, "rest/api/3/task/10000", "", nil).
+			Return(&http.Request{}, nil)
+
+		client.On("Call", &http.Request{}, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.TaskScheme).Status = model.BulkTaskStatusEnqueued
+			}).
+			Return(&model.ResponseScheme{}, nil)
+
+		taskService, err := NewTaskService(client, "3")
+		assert.NoError(t, err)
+
+		task, _, err := taskService.Wait(ctx, "10000", time.Hour)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, model.BulkTaskStatusEnqueued, task.Status)
+	})
+
+	t.Run("when the task id is not provided", func(t *testing.T) {
+
+		taskService, err := NewTaskService(nil, "3")
+		assert.NoError(t, err)
+
+		_, _, err = taskService.Wait(context.Background(), "", 0)
+		assert.ErrorIs(t, err, model.ErrNoTaskID)
+	})
+}
+
 func Test_NewTaskService(t *testing.T) {
 
 	type args struct {
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewTimeTrackingService creates a new instance of TimeTrackingService.
+func NewTimeTrackingService(client service.Connector, version string) (*TimeTrackingService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &TimeTrackingService{
+		internalClient: &internalTimeTrackingImpl{c: client, version: version},
+	}, nil
+}
+
+// TimeTrackingService provides methods to manage the time tracking configuration in Jira.
+type TimeTrackingService struct {
+	// internalClient is the connector interface for time tracking operations.
+	internalClient jira.TimeTrackingConnector
+}
+
+// Get returns the time tracking provider that is currently selected.
+//
+// The provider is nil when time tracking is disabled.
+//
+// GET /rest/api/{2-3}/configuration/timetracking
+func (t *TimeTrackingService) Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Get(ctx)
+}
+
+// Gets returns all time tracking providers.
+//
+// GET /rest/api/{2-3}/configuration/timetracking/list
+func (t *TimeTrackingService) Gets(ctx context.Context) ([]*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Gets(ctx)
+}
+
+// Select selects a time tracking provider.
+//
+// PUT /rest/api/{2-3}/configuration/timetracking
+func (t *TimeTrackingService) Select(ctx context.Context, payload *model.TimeTrackingProviderScheme) (*model.ResponseScheme, error) {
+	return t.internalClient.Select(ctx, payload)
+}
+
+// Settings returns the time tracking settings, the working hours and days are used to convert the durations.
+//
+// GET /rest/api/{2-3}/configuration/timetracking/options
+func (t *TimeTrackingService) Settings(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Settings(ctx)
+}
+
+// UpdateSettings sets the time tracking settings.
+//
+// PUT /rest/api/{2-3}/configuration/timetracking/options
+func (t *TimeTrackingService) UpdateSettings(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+	return t.internalClient.UpdateSettings(ctx, payload)
+}
+
+// ParseDuration parses a Jira duration, e.g. "1w 2d 3h 30m", using the working hours and days of the time tracking settings.
+func (t *TimeTrackingService) ParseDuration(ctx context.Context, value string) (model.TimeTrackingDuration, *model.ResponseScheme, error) {
+
+	settings, response, err := t.internalClient.Settings(ctx)
+	if err != nil {
+		return 0, response, err
+	}
+
+	duration, err := model.ParseTimeTrackingDuration(value, settings)
+	if err != nil {
+		return 0, response, err
+	}
+
+	return duration, response, nil
+}
+
+type internalTimeTrackingImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalTimeTrackingImpl) Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The response is decoded once the status is checked, Jira returns 204 No Content when time tracking is disabled.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.Code == http.StatusNoContent {
+		return nil, response, nil
+	}
+
+	provider := new(model.TimeTrackingProviderScheme)
+	if err = json.Unmarshal(response.Bytes.Bytes(), provider); err != nil {
+		return nil, response, err
+	}
+
+	return provider, response, nil
+}
+
+func (i *internalTimeTrackingImpl) Gets(ctx context.Context) ([]*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking/list", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var providers []*model.TimeTrackingProviderScheme
+	response, err := i.c.Call(request, &providers)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return providers, response, nil
+}
+
+func (i *internalTimeTrackingImpl) Select(ctx context.Context, payload *model.TimeTrackingProviderScheme) (*model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalTimeTrackingImpl) Settings(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking/options", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.TimeTrackingConfigurationScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
+
+func (i *internalTimeTrackingImpl) UpdateSettings(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking/options", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(model.TimeTrackingConfigurationScheme)
+	response, err := i.c.Call(request, settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return settings, response, nil
+}
@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWebhookService creates a new instance of WebhookService.
+func NewWebhookService(client service.Connector, version string) (*WebhookService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &WebhookService{
+		internalClient: &internalWebhookImpl{c: client, version: version},
+	}, nil
+}
+
+// WebhookService provides methods to manage the dynamic webhooks registered by the apps in Jira.
+type WebhookService struct {
+	// internalClient is the connector interface for webhook operations.
+	internalClient jira.WebhookConnector
+}
+
+// Gets returns a paginated list of the dynamic webhooks registered by the calling app.
+//
+// GET /rest/api/{2-3}/webhook
+func (w *WebhookService) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, startAt, maxResults)
+}
+
+// Register registers dynamic webhooks, the webhooks expire after 30 days unless they're refreshed.
+//
+// Only Connect and OAuth 2.0 apps can register webhooks.
+//
+// POST /rest/api/{2-3}/webhook
+func (w *WebhookService) Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Register(ctx, payload)
+}
+
+// Delete removes the dynamic webhooks registered by the calling app.
+//
+// DELETE /rest/api/{2-3}/webhook
+func (w *WebhookService) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, webhookIDs)
+}
+
+// Refresh extends the life of the dynamic webhooks, they expire 30 days after the refresh.
+//
+// PUT /rest/api/{2-3}/webhook/refresh
+func (w *WebhookService) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Refresh(ctx, webhookIDs)
+}
+
+// Failed returns the webhooks that failed to be delivered in the last 72 hours, sorted by failure time.
+//
+// The after parameter is the UNIX timestamp, in milliseconds, of the last failure of the previous page.
+//
+// GET /rest/api/{2-3}/webhook/failed
+func (w *WebhookService) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Failed(ctx, maxResults, after)
+}
+
+type internalWebhookImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWebhookImpl) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.WebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalWebhookImpl) Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registration := new(model.WebhookRegistrationScheme)
+	response, err := i.c.Call(request, registration)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return registration, response, nil
+}
+
+func (i *internalWebhookImpl) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, model.ErrNoWebhookIDs
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", &model.WebhookIDsPayloadScheme{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWebhookImpl) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, nil, model.ErrNoWebhookIDs
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/refresh", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.WebhookIDsPayloadScheme{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expiration := new(model.WebhookExpirationScheme)
+	response, err := i.c.Call(request, expiration)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return expiration, response, nil
+}
+
+func (i *internalWebhookImpl) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+
+	if maxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(maxResults))
+	}
+
+	if after > 0 {
+		params.Add("after", strconv.FormatInt(after, 10))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/webhook/failed", i.version))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.FailedWebhookPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
@@ -78,6 +78,8 @@ func (w *WorkflowStatusService) Search(ctx context.Context, options *model.Workf
 
 // Bulk returns a list of all statuses associated with active workflows.
 //
+// Map the statuses to their categories with models.NewStatusCategoryIndex.
+//
 // GET /rest/api/{2-3}/status
 //
 // https://docs.go-atlassian.io/jira-software-cloud/workflow/status#bulk-workflow-statuses
@@ -102,6 +104,41 @@ func (w *WorkflowStatusService) Get(ctx context.Context, idOrName string) (*mode
 	return w.internalClient.Get(ctx, idOrName)
 }
 
+// Categories returns a list of all status categories.
+//
+// GET /rest/api/{2-3}/statuscategory
+func (w *WorkflowStatusService) Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Categories(ctx)
+}
+
+// Category returns a status category by ID or key.
+//
+// GET /rest/api/{2-3}/statuscategory/{idOrKey}
+func (w *WorkflowStatusService) Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Category(ctx, idOrKey)
+}
+
+// ProjectUsages returns a page of the projects using a status.
+//
+// GET /rest/api/{2-3}/statuses/{statusID}/projectUsages
+func (w *WorkflowStatusService) ProjectUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusProjectUsageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.ProjectUsages(ctx, statusID, nextPageToken, maxResults)
+}
+
+// IssueTypeUsages returns a page of the issue types of a project using a status.
+//
+// GET /rest/api/{2-3}/statuses/{statusID}/project/{projectID}/issueTypeUsages
+func (w *WorkflowStatusService) IssueTypeUsages(ctx context.Context, statusID, projectID, nextPageToken string, maxResults int) (*model.StatusIssueTypeUsageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.IssueTypeUsages(ctx, statusID, projectID, nextPageToken, maxResults)
+}
+
+// WorkflowUsages returns a page of the workflows using a status.
+//
+// GET /rest/api/{2-3}/statuses/{statusID}/workflowUsages
+func (w *WorkflowStatusService) WorkflowUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusWorkflowUsageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.WorkflowUsages(ctx, statusID, nextPageToken, maxResults)
+}
+
 type internalWorkflowStatusImpl struct {
 	c       service.Connector
 	version string
@@ -278,3 +315,126 @@ func (i *internalWorkflowStatusImpl) Search(ctx context.Context, options *model.
 
 	return page, response, nil
 }
+
+func (i *internalWorkflowStatusImpl) Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuscategory", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []*model.StatusCategoryScheme
+	response, err := i.c.Call(request, &categories)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return categories, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error) {
+
+	if idOrKey == "" {
+		return nil, nil, model.ErrNoStatusCategoryIDOrKey
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuscategory/%v", i.version, idOrKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category := new(model.StatusCategoryScheme)
+	response, err := i.c.Call(request, category)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return category, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) ProjectUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusProjectUsageScheme, *model.ResponseScheme, error) {
+
+	if statusID == "" {
+		return nil, nil, model.ErrNoStatusID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuses/%v/projectUsages?%v", i.version, statusID, usageParams(nextPageToken, maxResults))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(model.StatusProjectUsageScheme)
+	response, err := i.c.Call(request, usage)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return usage, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) IssueTypeUsages(ctx context.Context, statusID, projectID, nextPageToken string, maxResults int) (*model.StatusIssueTypeUsageScheme, *model.ResponseScheme, error) {
+
+	if statusID == "" {
+		return nil, nil, model.ErrNoStatusID
+	}
+
+	if projectID == "" {
+		return nil, nil, model.ErrNoProjectID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuses/%v/project/%v/issueTypeUsages?%v", i.version, statusID, projectID, usageParams(nextPageToken, maxResults))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(model.StatusIssueTypeUsageScheme)
+	response, err := i.c.Call(request, usage)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return usage, response, nil
+}
+
+func (i *internalWorkflowStatusImpl) WorkflowUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusWorkflowUsageScheme, *model.ResponseScheme, error) {
+
+	if statusID == "" {
+		return nil, nil, model.ErrNoStatusID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/statuses/%v/workflowUsages?%v", i.version, statusID, usageParams(nextPageToken, maxResults))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(model.StatusWorkflowUsageScheme)
+	response, err := i.c.Call(request, usage)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return usage, response, nil
+}
+
+// usageParams returns the pagination parameters of the status usages.
+func usageParams(nextPageToken string, maxResults int) string {
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if nextPageToken != "" {
+		params.Add("nextPageToken", nextPageToken)
+	}
+
+	return params.Encode()
+}
@@ -0,0 +1,108 @@
+package issuecsv
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ADFToText renders an Atlassian Document Format node as plain text.
+// The blocks are separated by new lines and the list items are prefixed with "- " or their number.
+func ADFToText(document gjson.Result) string {
+
+	var builder strings.Builder
+	renderADF(&builder, document, "")
+
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+func renderADF(builder *strings.Builder, node gjson.Result, prefix string) {
+
+	switch node.Get("type").String() {
+	case "text":
+		builder.WriteString(node.Get("text").String())
+		return
+
+	case "hardBreak":
+		builder.WriteString("\n")
+		return
+
+	case "mention":
+		builder.WriteString(node.Get("attrs.text").String())
+		return
+
+	case "emoji":
+		builder.WriteString(node.Get("attrs.shortName").String())
+		return
+
+	case "inlineCard", "blockCard":
+		builder.WriteString(node.Get("attrs.url").String())
+		return
+
+	case "bulletList", "orderedList":
+		ordered := node.Get("type").String() == "orderedList"
+
+		for index, item := range node.Get("content").Array() {
+
+			marker := "- "
+			if ordered {
+				marker = strconv.Itoa(index+1) + ". "
+			}
+
+			builder.WriteString(prefix + marker)
+			renderADFContent(builder, item, prefix+"  ")
+		}
+
+		return
+
+	case "tableRow":
+		for index, cell := range node.Get("content").Array() {
+
+			if index > 0 {
+				builder.WriteString(" | ")
+			}
+
+			var cellBuilder strings.Builder
+			renderADFContent(&cellBuilder, cell, "")
+			builder.WriteString(strings.ReplaceAll(strings.TrimRight(cellBuilder.String(), "\n"), "\n", " "))
+		}
+
+		builder.WriteString("\n")
+		return
+	}
+
+	renderADFContent(builder, node, prefix)
+
+	switch node.Get("type").String() {
+	case "paragraph", "heading", "codeBlock", "rule":
+		builder.WriteString("\n")
+	}
+}
+
+func renderADFContent(builder *strings.Builder, node gjson.Result, prefix string) {
+
+	for _, child := range node.Get("content").Array() {
+		renderADF(builder, child, prefix)
+	}
+}
+
+// TextToADF converts a plain text into an Atlassian Document Format document, one paragraph per line.
+func TextToADF(text string) *model.CommentNodeScheme {
+
+	document := &model.CommentNodeScheme{Version: 1, Type: "doc"}
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+
+		paragraph := &model.CommentNodeScheme{Type: "paragraph"}
+		if line != "" {
+			paragraph.AppendNode(&model.CommentNodeScheme{Type: "text", Text: line})
+		}
+
+		document.AppendNode(paragraph)
+	}
+
+	return document
+}
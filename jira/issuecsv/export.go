@@ -0,0 +1,179 @@
+package issuecsv
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/jql"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Exporter writes the issues returned by a JQL search as CSV.
+type Exporter struct {
+	// Search runs the JQL search pages, see jql.Search.
+	Search jql.SearchFunc
+	// FieldList returns the fields of the instance, it's used to select the fields by name, e.g. client.Issue.Field.Gets.
+	FieldList func(ctx context.Context) ([]*model.IssueFieldScheme, *model.ResponseScheme, error)
+	// Fields contains the IDs or names of the exported fields, the issue key is always the first column.
+	// It defaults to the summary, status and assignee.
+	Fields []string
+	// Separator joins the values of the multi-value fields, "; " when empty.
+	Separator string
+	// PageSize is the number of issues fetched per search page, 100 when zero.
+	PageSize int
+}
+
+// Export runs the JQL search and writes the issues as CSV, including a header row with the field names.
+// It returns the number of issues written.
+func (e *Exporter) Export(ctx context.Context, query string, w io.Writer) (int, error) {
+
+	if e.Search == nil {
+		return 0, model.ErrNoSearchFunc
+	}
+
+	fields := e.Fields
+	if len(fields) == 0 {
+		fields = []string{"summary", "status", "assignee"}
+	}
+
+	fieldIDs, err := e.fieldIDs(ctx, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	separator := e.Separator
+	if separator == "" {
+		separator = "; "
+	}
+
+	writer := csv.NewWriter(w)
+	written, headerWritten := 0, false
+
+	err = jql.Pages(ctx, e.Search, query, fieldIDs, []string{"names"}, e.PageSize, func(page gjson.Result) error {
+
+		if !headerWritten {
+
+			header := []string{"Issue key"}
+			for index, fieldID := range fieldIDs {
+
+				name := page.Get("names").Get(gjson.Escape(fieldID)).String()
+				if name == "" {
+					name = fields[index]
+				}
+
+				header = append(header, name)
+			}
+
+			if err := writer.Write(header); err != nil {
+				return err
+			}
+
+			headerWritten = true
+		}
+
+		for _, issue := range page.Get("issues").Array() {
+
+			record := []string{issue.Get("key").String()}
+			for _, fieldID := range fieldIDs {
+				record = append(record, RenderValue(issue.Get("fields").Get(gjson.Escape(fieldID)), separator))
+			}
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+
+			written++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return written, err
+	}
+
+	writer.Flush()
+	return written, writer.Error()
+}
+
+// fieldIDs resolves the exported fields, the names are matched with the field list when it's provided.
+func (e *Exporter) fieldIDs(ctx context.Context, names []string) ([]string, error) {
+
+	if e.FieldList == nil {
+		return append([]string(nil), names...), nil
+	}
+
+	fields, _, err := e.FieldList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(names))
+	for index, nameOrID := range names {
+
+		ids[index] = nameOrID
+		for _, field := range fields {
+
+			if field.ID == nameOrID {
+				break
+			}
+
+			if strings.EqualFold(field.Name, nameOrID) {
+				ids[index] = field.ID
+				break
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// CascadingSeparator joins the parent and the child option of a cascading select, e.g. "EMEA - Spain".
+const CascadingSeparator = " - "
+
+// RenderValue renders a field value as text. The objects are rendered using their display name, name or value,
+// the documents (ADF) are rendered as text and the values of the arrays are joined with the separator.
+func RenderValue(value gjson.Result, separator string) string {
+
+	switch {
+	case !value.Exists() || value.Type == gjson.Null:
+		return ""
+
+	case value.IsArray():
+		var values []string
+		for _, element := range value.Array() {
+			if rendered := RenderValue(element, separator); rendered != "" {
+				values = append(values, rendered)
+			}
+		}
+
+		return strings.Join(values, separator)
+
+	case value.IsObject():
+		if value.Get("type").String() == "doc" {
+			return ADFToText(value)
+		}
+
+		for _, key := range []string{"displayName", "name", "value", "key", "originalEstimate"} {
+
+			if rendered := value.Get(key); rendered.Exists() && rendered.Type != gjson.Null {
+
+				if child := value.Get("child"); child.Exists() {
+					return rendered.String() + CascadingSeparator + RenderValue(child, separator)
+				}
+
+				return rendered.String()
+			}
+		}
+
+		return value.Raw
+
+	case value.Type == gjson.String:
+		return value.Str
+	}
+
+	return value.Raw
+}
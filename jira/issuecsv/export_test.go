@@ -0,0 +1,114 @@
+package issuecsv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestExporter_Export(t *testing.T) {
+
+	pages := map[string]string{
+		"": `{"names":{"summary":"Summary","labels":"Labels","customfield_10010":"Story Points","description":"Description","customfield_10020":"Region"},
+			"issues":[{"key":"KP-1","fields":{"summary":"First","labels":["a","b"],"customfield_10010":3,
+			"customfield_10020":{"id":"10","value":"EMEA","child":{"id":"11","value":"Spain"}},
+			"description":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Hello"}]},
+			{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"item"}]}]}]}]}}}],
+			"nextPageToken":"next"}`,
+		"next": `{"issues":[{"key":"KP-2","fields":{"summary":"Second","labels":[],"customfield_10010":null}}]}`,
+	}
+
+	testCases := []struct {
+		name     string
+		exporter *Exporter
+		want     string
+		written  int
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name: "when the issues are exported",
+			exporter: &Exporter{
+				Search: func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+					return &model.ResponseScheme{Bytes: *bytes.NewBufferString(pages[nextPageToken])}, nil
+				},
+				FieldList: func(ctx context.Context) ([]*model.IssueFieldScheme, *model.ResponseScheme, error) {
+					return []*model.IssueFieldScheme{{ID: "customfield_10010", Name: "Story Points"}, {ID: "summary", Name: "Summary"},
+						{ID: "customfield_10020", Name: "Region"}}, nil, nil
+				},
+				Fields: []string{"summary", "labels", "story points", "description", "region"},
+			},
+			want: "Issue key,Summary,Labels,Story Points,Description,Region\n" +
+				"KP-1,First,a; b,3,\"Hello\n- item\",EMEA - Spain\n" +
+				"KP-2,Second,,,,\n",
+			written: 2,
+		},
+
+		{
+			name:     "when the search function is not provided",
+			exporter: &Exporter{},
+			wantErr:  true,
+			Err:      model.ErrNoSearchFunc,
+		},
+
+		{
+			name: "when the search fails",
+			exporter: &Exporter{
+				Search: func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+					return nil, errors.New("error, request failed. Please fix me")
+				},
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed. Please fix me"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var buffer bytes.Buffer
+			fields := append([]string(nil), testCase.exporter.Fields...)
+			written, err := testCase.exporter.Export(context.Background(), "project = KP", &buffer)
+
+			// The default fields are not written back to the exporter.
+			assert.Equal(t, fields, append([]string(nil), testCase.exporter.Fields...))
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.written, written)
+			assert.Equal(t, testCase.want, buffer.String())
+		})
+	}
+}
+
+func TestRenderValue(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "when the value is a user", value: `{"accountId":"1","displayName":"Jane"}`, want: "Jane"},
+		{name: "when the value is a cascading option", value: `{"value":"A","child":{"value":"B"}}`, want: "A - B"},
+		{name: "when the value is an array of options", value: `[{"value":"A"},{"value":"B"}]`, want: "A|B"},
+		{name: "when the value is a number", value: `1.5`, want: "1.5"},
+		{name: "when the value is null", value: `null`, want: ""},
+		{name: "when the value is a table", value: `{"type":"doc","content":[{"type":"table","content":[{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"a"}]}]},{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"b"}]}]}]}]}]}`, want: "a | b"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, RenderValue(gjson.Parse(testCase.value), "|"))
+		})
+	}
+}
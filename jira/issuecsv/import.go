@@ -0,0 +1,709 @@
+package issuecsv
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The actions and statuses of the imported rows.
+const (
+	ActionCreate = "create" // The row creates an issue.
+	ActionUpdate = "update" // The row updates the issue of the key column.
+
+	StatusValid   = "valid"   // The row is valid, only used when the rows are only validated.
+	StatusInvalid = "invalid" // The row failed the validation, it wasn't sent.
+	StatusCreated = "created" // The issue was created.
+	StatusUpdated = "updated" // The issue was updated.
+	StatusFailed  = "failed"  // The issue creation or update failed.
+)
+
+// MetadataFetcher fetches the create and edit metadata, it's implemented by the metadata service, e.g. client.Issue.Metadata.
+type MetadataFetcher interface {
+	Get(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (gjson.Result, *model.ResponseScheme, error)
+	FetchIssueMappings(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error)
+	FetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error)
+}
+
+// Importer creates and updates issues from a CSV file.
+//
+// The first row is the header, the "Issue key", "Project" and "Issue Type" columns are matched case-insensitively.
+// The rows with an issue key update the issue, the other rows create an issue on the project with the issue type.
+// The remaining columns are matched with the fields of the create (or edit) screen by ID or name.
+type Importer struct {
+	// Metadata fetches the create and edit metadata of the rows.
+	Metadata MetadataFetcher
+	// Creates creates the issues, see CreatesV3 and CreatesV2.
+	Creates CreatesFunc
+	// Update updates the issues, see UpdateV3 and UpdateV2. The rows with an issue key fail when it's nil.
+	Update UpdateFunc
+	// Columns maps the column names to the field IDs or names, when they don't match the field names.
+	Columns map[string]string
+	// Separator splits the values of the multi-value fields, ";" when empty.
+	Separator string
+	// ADF converts the values of the rich text fields into documents, required by the v3 API.
+	ADF bool
+	// BatchSize is the number of issues created per call, 50 (the maximum) when zero.
+	BatchSize int
+	// ValidateOnly validates the rows without creating or updating the issues.
+	ValidateOnly bool
+}
+
+// RowResultScheme represents the result of an imported row.
+type RowResultScheme struct {
+	Row    int      `json:"row"`              // The number of the row, starting at 1 after the header.
+	Action string   `json:"action"`           // The action of the row, create or update.
+	Key    string   `json:"key,omitempty"`    // The key of the created or updated issue.
+	Status string   `json:"status"`           // The status of the row.
+	Errors []string `json:"errors,omitempty"` // The validation or API errors.
+}
+
+// ImportReportScheme represents the results of an import.
+type ImportReportScheme struct {
+	Rows []*RowResultScheme `json:"rows"` // The results, in the order of the rows.
+}
+
+// Failed returns the results of the invalid and failed rows.
+func (r *ImportReportScheme) Failed() []*RowResultScheme {
+
+	var failed []*RowResultScheme
+	for _, row := range r.Rows {
+		if row.Status == StatusInvalid || row.Status == StatusFailed {
+			failed = append(failed, row)
+		}
+	}
+
+	return failed
+}
+
+// WriteCSV writes a line per row, including a header row, the errors are joined with "; ".
+func (r *ImportReportScheme) WriteCSV(w io.Writer) error {
+
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"row", "action", "key", "status", "errors"}); err != nil {
+		return err
+	}
+
+	for _, row := range r.Rows {
+
+		record := []string{strconv.Itoa(row.Row), row.Action, row.Key, row.Status, strings.Join(row.Errors, "; ")}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Import reads the CSV rows, validates them and creates or updates the issues.
+//
+// The rows failing the validation or the API calls are reported on the result, the error is only returned
+// when the CSV file can't be read or the context is done.
+func (i *Importer) Import(ctx context.Context, r io.Reader) (*ImportReportScheme, error) {
+
+	if i.Creates == nil && !i.ValidateOnly {
+		return nil, model.ErrNoCreatesFunc
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, model.ErrNoCSVHeader
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	session := &importSession{importer: i, issueTypes: map[string][]gjson.Result{}, fields: map[string][]*fieldMeta{}}
+	report := &ImportReportScheme{}
+
+	var creates []*importRow
+	for number := 1; ; number++ {
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return report, err
+		}
+
+		row := session.prepare(ctx, number, header, record)
+		report.Rows = append(report.Rows, row.result)
+
+		if err = ctx.Err(); err != nil {
+			return report, err
+		}
+
+		if row.result.Status == StatusInvalid {
+			continue
+		}
+
+		if i.ValidateOnly {
+			row.result.Status = StatusValid
+			continue
+		}
+
+		if row.result.Action == ActionUpdate {
+			session.update(ctx, row)
+			continue
+		}
+
+		creates = append(creates, row)
+		if len(creates) == session.batchSize() {
+			session.create(ctx, creates)
+			creates = nil
+		}
+	}
+
+	if len(creates) != 0 {
+		session.create(ctx, creates)
+	}
+
+	return report, ctx.Err()
+}
+
+type importSession struct {
+	importer   *Importer
+	issueTypes map[string][]gjson.Result // The issue types, keyed by project.
+	fields     map[string][]*fieldMeta   // The create screen fields, keyed by project and issue type.
+}
+
+type importRow struct {
+	result *RowResultScheme
+	fields map[string]interface{}
+}
+
+// fieldMeta represents a field of the create or edit metadata.
+type fieldMeta struct {
+	ID              string
+	Name            string
+	Required        bool
+	HasDefaultValue bool
+	Schema          *model.IssueFieldSchemaScheme
+	AllowedValues   []gjson.Result
+}
+
+func (s *importSession) batchSize() int {
+
+	if s.importer.BatchSize <= 0 || s.importer.BatchSize > 50 {
+		return 50
+	}
+
+	return s.importer.BatchSize
+}
+
+// prepare maps the columns of a row to the fields of its create or edit screen.
+func (s *importSession) prepare(ctx context.Context, number int, header, record []string) *importRow {
+
+	row := &importRow{result: &RowResultScheme{Row: number, Action: ActionCreate}, fields: map[string]interface{}{}}
+
+	var project, issueType string
+	columns := map[string]string{}
+
+	for index, column := range header {
+
+		if index >= len(record) {
+			break
+		}
+
+		value := strings.TrimSpace(record[index])
+
+		target := column
+		if mapped, ok := s.importer.Columns[column]; ok {
+			target = mapped
+		}
+
+		switch strings.ToLower(strings.ReplaceAll(strings.TrimSpace(target), " ", "")) {
+		case "issuekey", "key":
+			row.result.Key = value
+		case "project":
+			project = value
+		case "issuetype":
+			issueType = value
+		default:
+			if value != "" {
+				columns[column] = value
+			}
+		}
+	}
+
+	if row.result.Key != "" {
+		row.result.Action = ActionUpdate
+	}
+
+	fields, err := s.screen(ctx, row.result.Key, project, issueType, row)
+	if err != nil {
+		row.result.Status, row.result.Errors = StatusInvalid, []string{err.Error()}
+		return row
+	}
+
+	var names []string
+	for column := range columns {
+		names = append(names, column)
+	}
+
+	sort.Strings(names)
+
+	for _, column := range names {
+
+		nameOrID := column
+		if mapped, ok := s.importer.Columns[column]; ok {
+			nameOrID = mapped
+		}
+
+		field := findFieldMeta(fields, nameOrID)
+		if field == nil {
+			row.result.Errors = append(row.result.Errors, fmt.Sprintf("column %v: the field is not on the screen", column))
+			continue
+		}
+
+		value, err := s.convert(field, columns[column])
+		if err != nil {
+			row.result.Errors = append(row.result.Errors, fmt.Sprintf("column %v: %v", column, err))
+			continue
+		}
+
+		row.fields[field.ID] = value
+	}
+
+	if row.result.Action == ActionCreate {
+
+		for _, field := range fields {
+
+			if _, ok := row.fields[field.ID]; !ok && field.Required && !field.HasDefaultValue {
+				row.result.Errors = append(row.result.Errors, fmt.Sprintf("field %v: the field is required", field.Name))
+			}
+		}
+	}
+
+	if len(row.result.Errors) != 0 {
+		row.result.Status = StatusInvalid
+	}
+
+	return row
+}
+
+// screen returns the fields of the edit screen of the issue, or the create screen of the project and issue type.
+func (s *importSession) screen(ctx context.Context, issueKey, project, issueType string, row *importRow) ([]*fieldMeta, error) {
+
+	if s.importer.Metadata == nil {
+		return nil, model.ErrNoMetadataService
+	}
+
+	if issueKey != "" {
+
+		metadata, _, err := s.importer.Metadata.Get(ctx, issueKey, false, false)
+		if err != nil {
+			return nil, err
+		}
+
+		var fields []*fieldMeta
+		metadata.Get("fields").ForEach(func(key, value gjson.Result) bool {
+			fields = append(fields, newFieldMeta(key.String(), value))
+			return true
+		})
+
+		return fields, nil
+	}
+
+	if project == "" || issueType == "" {
+		return nil, model.ErrNoProjectOrIssueType
+	}
+
+	issueTypeID, err := s.issueTypeID(ctx, project, issueType)
+	if err != nil {
+		return nil, err
+	}
+
+	row.fields["project"] = map[string]interface{}{"key": project}
+	row.fields["issuetype"] = map[string]interface{}{"id": issueTypeID}
+
+	cacheKey := project + "/" + issueTypeID
+	if fields, ok := s.fields[cacheKey]; ok {
+		return fields, nil
+	}
+
+	var fields []*fieldMeta
+	for startAt := 0; ; {
+
+		page, _, err := s.importer.Metadata.FetchFieldMappings(ctx, project, issueTypeID, startAt, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		values := page.Get("fields").Array()
+		if len(values) == 0 {
+			values = page.Get("results").Array()
+		}
+
+		for _, value := range values {
+			fields = append(fields, newFieldMeta(value.Get("fieldId").String(), value))
+		}
+
+		startAt += len(values)
+		if len(values) == 0 || startAt >= int(page.Get("total").Int()) {
+			break
+		}
+	}
+
+	s.fields[cacheKey] = fields
+	return fields, nil
+}
+
+func (s *importSession) issueTypeID(ctx context.Context, project, issueType string) (string, error) {
+
+	issueTypes, ok := s.issueTypes[project]
+	if !ok {
+
+		for startAt := 0; ; {
+
+			page, _, err := s.importer.Metadata.FetchIssueMappings(ctx, project, startAt, 100)
+			if err != nil {
+				return "", err
+			}
+
+			values := page.Get("issueTypes").Array()
+			if len(values) == 0 {
+				values = page.Get("values").Array()
+			}
+
+			issueTypes = append(issueTypes, values...)
+
+			startAt += len(values)
+			if len(values) == 0 || startAt >= int(page.Get("total").Int()) {
+				break
+			}
+		}
+
+		s.issueTypes[project] = issueTypes
+	}
+
+	for _, candidate := range issueTypes {
+		if candidate.Get("id").String() == issueType || strings.EqualFold(candidate.Get("name").String(), issueType) {
+			return candidate.Get("id").String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("the issue type %v is not available on the project %v", issueType, project)
+}
+
+// convert converts a cell into the value of the field, using the field schema and allowed values.
+func (s *importSession) convert(field *fieldMeta, text string) (interface{}, error) {
+
+	schema := field.Schema
+	if schema == nil {
+		schema = &model.IssueFieldSchemaScheme{Type: "string"}
+	}
+
+	if schema.Type == "array" {
+
+		separator := s.importer.Separator
+		if separator == "" {
+			separator = ";"
+		}
+
+		items := &fieldMeta{ID: field.ID, Name: field.Name, AllowedValues: field.AllowedValues,
+			Schema: &model.IssueFieldSchemaScheme{Type: schema.Items, System: schema.System, Custom: schema.Custom}}
+
+		var values []interface{}
+		for _, element := range strings.Split(text, separator) {
+
+			if element = strings.TrimSpace(element); element == "" {
+				continue
+			}
+
+			value, err := s.convert(items, element)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	}
+
+	switch schema.Type {
+	case "string":
+		if s.importer.ADF && isRichTextField(schema) {
+			return TextToADF(text), nil
+		}
+
+		return text, nil
+
+	case "number":
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", text)
+		}
+
+		return number, nil
+
+	case "date":
+		if _, err := time.Parse("2006-01-02", text); err != nil {
+			return nil, fmt.Errorf("invalid date %q, the format is YYYY-MM-DD", text)
+		}
+
+		return text, nil
+
+	case "user":
+		return map[string]interface{}{"accountId": text}, nil
+
+	case "issuelink":
+		return map[string]interface{}{"key": text}, nil
+
+	case "timetracking":
+		return map[string]interface{}{"originalEstimate": text}, nil
+
+	case "option-with-child":
+		return cascadingValue(field.AllowedValues, text)
+
+	case "option", "priority", "resolution", "version", "component", "securitylevel":
+		if len(field.AllowedValues) == 0 {
+
+			if schema.Type == "option" {
+				return map[string]interface{}{"value": text}, nil
+			}
+
+			return map[string]interface{}{"name": text}, nil
+		}
+
+		if allowed, ok := allowedValue(field.AllowedValues, text); ok {
+			return map[string]interface{}{"id": allowed.Get("id").String()}, nil
+		}
+
+		return nil, fmt.Errorf("the value %q is not allowed", text)
+	}
+
+	return text, nil
+}
+
+// cascadingValue converts a "Parent - Child" text to a cascading select value, the parent is matched with the
+// allowed values and the child with the children of the parent.
+func cascadingValue(allowedValues []gjson.Result, text string) (interface{}, error) {
+
+	parent, child, hasChild := strings.Cut(text, CascadingSeparator)
+	parent, child = strings.TrimSpace(parent), strings.TrimSpace(child)
+
+	if len(allowedValues) == 0 {
+
+		value := map[string]interface{}{"value": parent}
+		if hasChild && child != "" {
+			value["child"] = map[string]interface{}{"value": child}
+		}
+
+		return value, nil
+	}
+
+	allowed, ok := allowedValue(allowedValues, parent)
+	if !ok {
+		return nil, fmt.Errorf("the value %q is not allowed", parent)
+	}
+
+	value := map[string]interface{}{"id": allowed.Get("id").String()}
+	if !hasChild || child == "" {
+		return value, nil
+	}
+
+	option, ok := allowedValue(allowed.Get("children").Array(), child)
+	if !ok {
+		return nil, fmt.Errorf("the value %q is not allowed for %q", child, parent)
+	}
+
+	value["child"] = map[string]interface{}{"id": option.Get("id").String()}
+	return value, nil
+}
+
+// allowedValue returns the allowed value matching the text by ID, name or value.
+func allowedValue(allowedValues []gjson.Result, text string) (gjson.Result, bool) {
+
+	for _, allowed := range allowedValues {
+
+		for _, key := range []string{"id", "name", "value"} {
+			if candidate := allowed.Get(key).String(); candidate != "" && strings.EqualFold(candidate, text) {
+				return allowed, true
+			}
+		}
+	}
+
+	return gjson.Result{}, false
+}
+
+// create creates a batch of issues, the errors are reported on the rows using the failed element numbers.
+func (s *importSession) create(ctx context.Context, rows []*importRow) {
+
+	issues := make([]map[string]interface{}, len(rows))
+	for index, row := range rows {
+		issues[index] = row.fields
+	}
+
+	result, response, err := s.importer.Creates(ctx, issues)
+
+	// The errors are returned on the response body when all the issues fail.
+	if result == nil && response != nil && response.Bytes.Len() != 0 {
+
+		result = new(model.IssueBulkResponseScheme)
+		if json.Unmarshal(response.Bytes.Bytes(), result) != nil || len(result.Errors) == 0 {
+			result = nil
+		}
+	}
+
+	if result == nil {
+
+		for _, row := range rows {
+			row.result.Status, row.result.Errors = StatusFailed, []string{errorMessage(err, "no issues returned")}
+		}
+
+		return
+	}
+
+	failed := make(map[int]bool)
+	for _, failure := range result.Errors {
+
+		if failure.FailedElementNumber < 0 || failure.FailedElementNumber >= len(rows) {
+			continue
+		}
+
+		row := rows[failure.FailedElementNumber]
+		row.result.Status = StatusFailed
+		row.result.Errors = append(row.result.Errors, failure.ElementErrors.ErrorMessages...)
+
+		var keys []string
+		for key := range failure.ElementErrors.Errors {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			row.result.Errors = append(row.result.Errors, fmt.Sprintf("%v: %v", key, failure.ElementErrors.Errors[key]))
+		}
+
+		failed[failure.FailedElementNumber] = true
+	}
+
+	created := 0
+	for index, row := range rows {
+
+		if failed[index] {
+			continue
+		}
+
+		if created >= len(result.Issues) {
+			row.result.Status, row.result.Errors = StatusFailed, []string{errorMessage(err, "no issue returned")}
+			continue
+		}
+
+		row.result.Status, row.result.Key = StatusCreated, result.Issues[created].Key
+		created++
+	}
+}
+
+func (s *importSession) update(ctx context.Context, row *importRow) {
+
+	if s.importer.Update == nil {
+		row.result.Status, row.result.Errors = StatusFailed, []string{"no update function set"}
+		return
+	}
+
+	response, err := s.importer.Update(ctx, row.result.Key, row.fields)
+	if err == nil {
+		row.result.Status = StatusUpdated
+		return
+	}
+
+	row.result.Status, row.result.Errors = StatusFailed, []string{err.Error()}
+
+	if response != nil {
+
+		apiError := struct {
+			ErrorMessages []string          `json:"errorMessages"`
+			Errors        map[string]string `json:"errors"`
+		}{}
+
+		if json.Unmarshal(response.Bytes.Bytes(), &apiError) == nil {
+
+			row.result.Errors = append(row.result.Errors, apiError.ErrorMessages...)
+			for key, message := range apiError.Errors {
+				row.result.Errors = append(row.result.Errors, fmt.Sprintf("%v: %v", key, message))
+			}
+
+			sort.Strings(row.result.Errors[1:])
+		}
+	}
+}
+
+func newFieldMeta(fieldID string, value gjson.Result) *fieldMeta {
+
+	field := &fieldMeta{
+		ID:              fieldID,
+		Name:            value.Get("name").String(),
+		Required:        value.Get("required").Bool(),
+		HasDefaultValue: value.Get("hasDefaultValue").Bool(),
+		AllowedValues:   value.Get("allowedValues").Array(),
+	}
+
+	if schema := value.Get("schema"); schema.Exists() {
+
+		field.Schema = new(model.IssueFieldSchemaScheme)
+		_ = json.Unmarshal([]byte(schema.Raw), field.Schema)
+	}
+
+	return field
+}
+
+func findFieldMeta(fields []*fieldMeta, nameOrID string) *fieldMeta {
+
+	for _, field := range fields {
+		if field.ID == nameOrID {
+			return field
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, nameOrID) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func isRichTextField(schema *model.IssueFieldSchemaScheme) bool {
+
+	switch {
+	case schema.System == "description", schema.System == "environment":
+		return true
+	case schema.Custom == "com.atlassian.jira.plugin.system.customfieldtypes:textarea":
+		return true
+	}
+
+	return false
+}
+
+func errorMessage(err error, fallback string) string {
+
+	if err != nil {
+		return err.Error()
+	}
+
+	return fallback
+}
@@ -0,0 +1,259 @@
+package issuecsv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type metadataMocked struct {
+	fieldCalls int
+}
+
+func (m *metadataMocked) Get(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (gjson.Result, *model.ResponseScheme, error) {
+	return gjson.Parse(`{"fields":{"summary":{"name":"Summary","schema":{"type":"string","system":"summary"}},
+		"labels":{"name":"Labels","schema":{"type":"array","items":"string","system":"labels"}}}}`), nil, nil
+}
+
+func (m *metadataMocked) FetchIssueMappings(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error) {
+	return gjson.Parse(`{"issueTypes":[{"id":"10001","name":"Task"},{"id":"10002","name":"Bug"}],"total":2}`), nil, nil
+}
+
+func (m *metadataMocked) FetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error) {
+
+	m.fieldCalls++
+	return gjson.Parse(`{"fields":[
+		{"fieldId":"summary","name":"Summary","required":true,"schema":{"type":"string","system":"summary"}},
+		{"fieldId":"description","name":"Description","schema":{"type":"string","system":"description"}},
+		{"fieldId":"priority","name":"Priority","hasDefaultValue":true,"required":true,"schema":{"type":"priority","system":"priority"},
+			"allowedValues":[{"id":"1","name":"High"},{"id":"2","name":"Low"}]},
+		{"fieldId":"duedate","name":"Due date","schema":{"type":"date","system":"duedate"}},
+		{"fieldId":"customfield_10010","name":"Story Points","schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float"}}
+	],"total":5}`), nil, nil
+}
+
+func TestImporter_Import(t *testing.T) {
+
+	file := "Project,Issue Type,Summary,Description,Priority,Story Points,Due date,Issue key,Labels\n" +
+		"KP,Task,First,Hello,high,3,2024-01-31,,\n" +
+		"KP,Story,Second,,,,,,\n" +
+		"KP,Bug,,,,abc,31/01/2024,,\n" +
+		"KP,bug,Third,,Low,,,,\n" +
+		",,Updated,,,,,KP-9,a;b\n"
+
+	var created [][]map[string]interface{}
+	var updated map[string]interface{}
+
+	metadata := &metadataMocked{}
+	importer := &Importer{
+		Metadata: metadata,
+		Creates: func(ctx context.Context, issues []map[string]interface{}) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+			created = append(created, issues)
+
+			result := new(model.IssueBulkResponseScheme)
+			err := json.Unmarshal([]byte(`{"issues":[{"id":"1","key":"KP-1"}],
+				"errors":[{"status":400,"failedElementNumber":1,"elementErrors":{"errors":{"summary":"too long"}}}]}`), result)
+
+			return result, nil, err
+		},
+		Update: func(ctx context.Context, issueKeyOrID string, fields map[string]interface{}) (*model.ResponseScheme, error) {
+			updated = fields
+			return nil, nil
+		},
+		ADF: true,
+	}
+
+	report, err := importer.Import(context.Background(), strings.NewReader(file))
+	assert.NoError(t, err)
+
+	statuses := make([]string, len(report.Rows))
+	for index, row := range report.Rows {
+		statuses[index] = row.Status
+	}
+
+	assert.Equal(t, []string{StatusCreated, StatusInvalid, StatusInvalid, StatusFailed, StatusUpdated}, statuses)
+	assert.Equal(t, "KP-1", report.Rows[0].Key)
+	assert.Equal(t, []string{"the issue type Story is not available on the project KP"}, report.Rows[1].Errors)
+	assert.Equal(t, []string{
+		"column Due date: invalid date \"31/01/2024\", the format is YYYY-MM-DD",
+		"column Story Points: invalid number \"abc\"",
+		"field Summary: the field is required",
+	}, report.Rows[2].Errors)
+	assert.Equal(t, []string{"summary: too long"}, report.Rows[3].Errors)
+	assert.Len(t, report.Failed(), 3)
+	assert.Equal(t, 2, metadata.fieldCalls)
+
+	if assert.Len(t, created, 1) && assert.Len(t, created[0], 2) {
+
+		fields := created[0][0]
+		assert.Equal(t, map[string]interface{}{"key": "KP"}, fields["project"])
+		assert.Equal(t, map[string]interface{}{"id": "10001"}, fields["issuetype"])
+		assert.Equal(t, map[string]interface{}{"id": "1"}, fields["priority"])
+		assert.Equal(t, 3.0, fields["customfield_10010"])
+		assert.Equal(t, TextToADF("Hello"), fields["description"])
+	}
+
+	assert.Equal(t, map[string]interface{}{"summary": "Updated", "labels": []interface{}{"a", "b"}}, updated)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, report.WriteCSV(&buffer))
+	assert.True(t, strings.HasPrefix(buffer.String(), "row,action,key,status,errors\n1,create,KP-1,created,\n"))
+}
+
+func TestImporter_Import_ValidateOnly(t *testing.T) {
+
+	importer := &Importer{Metadata: &metadataMocked{}, ValidateOnly: true}
+
+	report, err := importer.Import(context.Background(), strings.NewReader("Project,Issue Type,Summary\nKP,Task,First\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusValid, report.Rows[0].Status)
+}
+
+func TestImporter_Import_Screen(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		importer *Importer
+		file     string
+		want     []string
+	}{
+		{
+			name:     "when the metadata service is not provided",
+			importer: &Importer{ValidateOnly: true},
+			file:     "Project,Issue Type,Summary\nKP,Task,First\n",
+			want:     []string{model.ErrNoMetadataService.Error()},
+		},
+
+		{
+			name:     "when the issue type is not provided",
+			importer: &Importer{Metadata: &metadataMocked{}, ValidateOnly: true},
+			file:     "Project,Summary\nKP,First\n",
+			want:     []string{model.ErrNoProjectOrIssueType.Error()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			report, err := testCase.importer.Import(context.Background(), strings.NewReader(testCase.file))
+			assert.NoError(t, err)
+
+			if assert.Len(t, report.Rows, 1) {
+				assert.Equal(t, StatusInvalid, report.Rows[0].Status)
+				assert.Equal(t, testCase.want, report.Rows[0].Errors)
+			}
+		})
+	}
+}
+
+func Test_cascadingValue(t *testing.T) {
+
+	allowedValues := gjson.Parse(`[{"id":"10","value":"EMEA","children":[{"id":"11","value":"Spain"},{"id":"12","value":"France"}]},
+		{"id":"20","value":"APAC"}]`).Array()
+
+	testCases := []struct {
+		name          string
+		allowedValues []gjson.Result
+		text          string
+		want          interface{}
+		wantErr       bool
+		Err           error
+	}{
+		{
+			name:          "when the parent and the child are allowed",
+			allowedValues: allowedValues,
+			text:          "emea - Spain",
+			want:          map[string]interface{}{"id": "10", "child": map[string]interface{}{"id": "11"}},
+		},
+
+		{
+			name:          "when only the parent is provided",
+			allowedValues: allowedValues,
+			text:          "APAC",
+			want:          map[string]interface{}{"id": "20"},
+		},
+
+		{
+			name: "when there are no allowed values",
+			text: "EMEA - Spain",
+			want: map[string]interface{}{"value": "EMEA", "child": map[string]interface{}{"value": "Spain"}},
+		},
+
+		{
+			name:          "when the parent is not allowed",
+			allowedValues: allowedValues,
+			text:          "LATAM - Peru",
+			wantErr:       true,
+			Err:           errors.New("the value \"LATAM\" is not allowed"),
+		},
+
+		{
+			name:          "when the child is not allowed",
+			allowedValues: allowedValues,
+			text:          "EMEA - Peru",
+			wantErr:       true,
+			Err:           errors.New("the value \"Peru\" is not allowed for \"EMEA\""),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := cascadingValue(testCase.allowedValues, testCase.text)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+
+			// The exported text of the value is imported as the same option.
+			if rendered, err := json.Marshal(got); assert.NoError(t, err) && len(testCase.allowedValues) == 0 {
+				assert.Equal(t, testCase.text, RenderValue(gjson.ParseBytes(rendered), "; "))
+			}
+		})
+	}
+}
+
+func TestImporter_Import_Errors(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		importer *Importer
+		file     string
+		Err      error
+	}{
+		{
+			name:     "when the creates function is not provided",
+			importer: &Importer{},
+			Err:      model.ErrNoCreatesFunc,
+		},
+
+		{
+			name: "when the file is empty",
+			importer: &Importer{Creates: func(ctx context.Context, issues []map[string]interface{}) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+				return nil, nil, errors.New("unexpected")
+			}},
+			Err: model.ErrNoCSVHeader,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			_, err := testCase.importer.Import(context.Background(), strings.NewReader(testCase.file))
+			assert.ErrorIs(t, err, testCase.Err)
+		})
+	}
+}
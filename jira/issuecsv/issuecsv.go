@@ -0,0 +1,87 @@
+// Package issuecsv exports Jira issues to CSV files and imports CSV files as Jira issues.
+//
+// The exporter runs a JQL search and writes a column per field, the custom fields can be selected by name,
+// the documents (ADF) are rendered as text and the multi-value fields are flattened:
+//
+//	exporter := &issuecsv.Exporter{
+//		Search:    jql.Search(client.Issue.Search.SearchJQL),
+//		FieldList: client.Issue.Field.Gets,
+//		Fields:    []string{"summary", "status", "assignee", "Story Points", "labels"},
+//	}
+//
+//	rows, err := exporter.Export(ctx, "project = KP ORDER BY key", os.Stdout)
+//
+// The importer maps the columns to the fields using the create (or edit) metadata of each row,
+// validates the rows, creates the issues in batches and updates the rows with an issue key:
+//
+//	importer := &issuecsv.Importer{
+//		Metadata: client.Issue.Metadata,
+//		Creates:  issuecsv.CreatesV3(client.Issue.Creates),
+//		Update:   issuecsv.UpdateV3(client.Issue.Update),
+//		ADF:      true,
+//	}
+//
+//	report, err := importer.Import(ctx, file)
+//	err = report.WriteCSV(resultFile)
+package issuecsv
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// CreatesFunc creates up to 50 issues, using the fields keyed by field ID.
+type CreatesFunc func(ctx context.Context, issues []map[string]interface{}) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)
+
+// CreatesV3 adapts the Creates method of the v3 issue service, e.g. client.Issue.Creates.
+func CreatesV3(creates func(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)) CreatesFunc {
+
+	return func(ctx context.Context, issues []map[string]interface{}) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+		payload := make([]*model.IssueBulkSchemeV3, len(issues))
+		for index, fields := range issues {
+			payload[index] = &model.IssueBulkSchemeV3{Payload: &model.IssueScheme{}, CustomFields: rawFields(fields)}
+		}
+
+		return creates(ctx, payload)
+	}
+}
+
+// CreatesV2 adapts the Creates method of the v2 issue service, e.g. client.Issue.Creates.
+func CreatesV2(creates func(ctx context.Context, payload []*model.IssueBulkSchemeV2) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error)) CreatesFunc {
+
+	return func(ctx context.Context, issues []map[string]interface{}) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+		payload := make([]*model.IssueBulkSchemeV2, len(issues))
+		for index, fields := range issues {
+			payload[index] = &model.IssueBulkSchemeV2{Payload: &model.IssueSchemeV2{}, CustomFields: rawFields(fields)}
+		}
+
+		return creates(ctx, payload)
+	}
+}
+
+// UpdateFunc updates an issue, using the fields keyed by field ID.
+type UpdateFunc func(ctx context.Context, issueKeyOrID string, fields map[string]interface{}) (*model.ResponseScheme, error)
+
+// UpdateV3 adapts the Update method of the v3 issue service, e.g. client.Issue.Update.
+func UpdateV3(update func(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.ResponseScheme, error)) UpdateFunc {
+
+	return func(ctx context.Context, issueKeyOrID string, fields map[string]interface{}) (*model.ResponseScheme, error) {
+		return update(ctx, issueKeyOrID, false, &model.IssueScheme{}, rawFields(fields), nil)
+	}
+}
+
+// UpdateV2 adapts the Update method of the v2 issue service, e.g. client.Issue.Update.
+func UpdateV2(update func(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueSchemeV2, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.ResponseScheme, error)) UpdateFunc {
+
+	return func(ctx context.Context, issueKeyOrID string, fields map[string]interface{}) (*model.ResponseScheme, error) {
+		return update(ctx, issueKeyOrID, false, &model.IssueSchemeV2{}, rawFields(fields), nil)
+	}
+}
+
+// rawFields wraps the fields as custom fields, so they're merged as they are into the issue payloads.
+func rawFields(fields map[string]interface{}) *model.CustomFields {
+	return &model.CustomFields{Fields: []map[string]interface{}{{"fields": fields}}}
+}
@@ -0,0 +1,369 @@
+package jql
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Operator is a JQL clause operator.
+type Operator string
+
+// The operators supported by the JQL clauses.
+const (
+	Equals            Operator = "="
+	NotEquals         Operator = "!="
+	GreaterThan       Operator = ">"
+	GreaterThanEquals Operator = ">="
+	LessThan          Operator = "<"
+	LessThanEquals    Operator = "<="
+	Contains          Operator = "~"
+	NotContains       Operator = "!~"
+	In                Operator = "IN"
+	NotIn             Operator = "NOT IN"
+	Is                Operator = "IS"
+	IsNot             Operator = "IS NOT"
+	Was               Operator = "WAS"
+	WasIn             Operator = "WAS IN"
+	WasNot            Operator = "WAS NOT"
+	WasNotIn          Operator = "WAS NOT IN"
+	Changed           Operator = "CHANGED"
+)
+
+// Clause represents a node of the JQL where section.
+type Clause interface {
+	String() string
+	clause()
+}
+
+// CompoundClause joins the clauses using the AND or OR operators.
+type CompoundClause struct {
+	Operator string   // The operator joining the clauses, AND or OR.
+	Clauses  []Clause // The joined clauses.
+}
+
+// String renders the clauses, the nested compound clauses are enclosed in parentheses.
+// The nil and empty clauses are skipped.
+func (c *CompoundClause) String() string {
+
+	clauses := make([]string, 0, len(c.Clauses))
+	for _, clause := range c.Clauses {
+
+		if clause == nil {
+			continue
+		}
+
+		rendered := clause.String()
+		if rendered == "" {
+			continue
+		}
+
+		if _, isCompound := clause.(*CompoundClause); isCompound {
+			rendered = "(" + rendered + ")"
+		}
+
+		clauses = append(clauses, rendered)
+	}
+
+	return strings.Join(clauses, " "+c.Operator+" ")
+}
+
+func (*CompoundClause) clause() {}
+
+// NotClause negates a clause.
+type NotClause struct {
+	Clause Clause // The negated clause.
+}
+
+// String renders the negated clause, the compound clauses are enclosed in parentheses.
+// It returns an empty string when the negated clause is nil or empty.
+func (n *NotClause) String() string {
+
+	if n.Clause == nil || n.Clause.String() == "" {
+		return ""
+	}
+
+	if _, isCompound := n.Clause.(*CompoundClause); isCompound {
+		return "NOT (" + n.Clause.String() + ")"
+	}
+
+	return "NOT " + n.Clause.String()
+}
+
+func (*NotClause) clause() {}
+
+// TerminalClause compares a field against an operand.
+// The history clauses (WAS, CHANGED) can include predicates such as AFTER or BY.
+type TerminalClause struct {
+	Field      string       // The name of the field.
+	Operator   Operator     // The clause operator.
+	Operand    Operand      // The operand, nil for the CHANGED operator.
+	Predicates []*Predicate // The predicates of the history clauses.
+}
+
+// String renders the clause, quoting the field name when required.
+func (t *TerminalClause) String() string {
+
+	var builder strings.Builder
+	builder.WriteString(QuoteField(t.Field))
+	builder.WriteString(" ")
+	builder.WriteString(string(t.Operator))
+
+	if t.Operand != nil {
+		builder.WriteString(" ")
+		builder.WriteString(t.Operand.String())
+	}
+
+	for _, predicate := range t.Predicates {
+		builder.WriteString(" ")
+		builder.WriteString(predicate.String())
+	}
+
+	return builder.String()
+}
+
+func (*TerminalClause) clause() {}
+
+// After adds the AFTER predicate to a history clause.
+func (t *TerminalClause) After(operand Operand) *TerminalClause { return t.predicate("AFTER", operand) }
+
+// Before adds the BEFORE predicate to a history clause.
+func (t *TerminalClause) Before(operand Operand) *TerminalClause {
+	return t.predicate("BEFORE", operand)
+}
+
+// By adds the BY predicate to a history clause.
+func (t *TerminalClause) By(operand Operand) *TerminalClause { return t.predicate("BY", operand) }
+
+// During adds the DURING predicate to a history clause.
+func (t *TerminalClause) During(from, to Operand) *TerminalClause {
+	return t.predicate("DURING", List{from, to})
+}
+
+// On adds the ON predicate to a history clause.
+func (t *TerminalClause) On(operand Operand) *TerminalClause { return t.predicate("ON", operand) }
+
+// From adds the FROM predicate to a history clause.
+func (t *TerminalClause) From(operand Operand) *TerminalClause { return t.predicate("FROM", operand) }
+
+// To adds the TO predicate to a history clause.
+func (t *TerminalClause) To(operand Operand) *TerminalClause { return t.predicate("TO", operand) }
+
+func (t *TerminalClause) predicate(operator string, operand Operand) *TerminalClause {
+	t.Predicates = append(t.Predicates, &Predicate{Operator: operator, Operand: operand})
+	return t
+}
+
+// Predicate represents a predicate of a history clause.
+type Predicate struct {
+	Operator string  // The predicate operator, e.g. AFTER, BEFORE, BY, DURING, ON, FROM or TO.
+	Operand  Operand // The predicate operand.
+}
+
+// String renders the predicate.
+func (p *Predicate) String() string {
+
+	if p.Operand == nil {
+		return p.Operator
+	}
+
+	return p.Operator + " " + p.Operand.String()
+}
+
+// FieldRef is used to build the clauses of a field.
+type FieldRef string
+
+// Field returns a reference used to build the clauses of the field.
+// The field can be a system field (e.g. "project"), a custom field ID ("cf[10020]") or a custom field name.
+func Field(name string) FieldRef { return FieldRef(name) }
+
+func (f FieldRef) clause(operator Operator, operand Operand) *TerminalClause {
+	return &TerminalClause{Field: string(f), Operator: operator, Operand: operand}
+}
+
+// Eq creates a "field = operand" clause.
+func (f FieldRef) Eq(operand Operand) *TerminalClause { return f.clause(Equals, operand) }
+
+// NotEq creates a "field != operand" clause.
+func (f FieldRef) NotEq(operand Operand) *TerminalClause { return f.clause(NotEquals, operand) }
+
+// Gt creates a "field > operand" clause.
+func (f FieldRef) Gt(operand Operand) *TerminalClause { return f.clause(GreaterThan, operand) }
+
+// Gte creates a "field >= operand" clause.
+func (f FieldRef) Gte(operand Operand) *TerminalClause { return f.clause(GreaterThanEquals, operand) }
+
+// Lt creates a "field < operand" clause.
+func (f FieldRef) Lt(operand Operand) *TerminalClause { return f.clause(LessThan, operand) }
+
+// Lte creates a "field <= operand" clause.
+func (f FieldRef) Lte(operand Operand) *TerminalClause { return f.clause(LessThanEquals, operand) }
+
+// Contains creates a "field ~ operand" clause.
+func (f FieldRef) Contains(operand Operand) *TerminalClause { return f.clause(Contains, operand) }
+
+// NotContains creates a "field !~ operand" clause.
+func (f FieldRef) NotContains(operand Operand) *TerminalClause {
+	return f.clause(NotContains, operand)
+}
+
+// In creates a "field IN (operands)" clause, at least one operand is required, see Query.Validate.
+func (f FieldRef) In(operands ...Operand) *TerminalClause { return f.clause(In, listOperand(operands)) }
+
+// NotIn creates a "field NOT IN (operands)" clause, at least one operand is required, see Query.Validate.
+func (f FieldRef) NotIn(operands ...Operand) *TerminalClause {
+	return f.clause(NotIn, listOperand(operands))
+}
+
+// Is creates a "field IS keyword" clause.
+func (f FieldRef) Is(keyword Keyword) *TerminalClause { return f.clause(Is, keyword) }
+
+// IsNot creates a "field IS NOT keyword" clause.
+func (f FieldRef) IsNot(keyword Keyword) *TerminalClause { return f.clause(IsNot, keyword) }
+
+// Was creates a "field WAS operand" history clause.
+func (f FieldRef) Was(operand Operand) *TerminalClause { return f.clause(Was, operand) }
+
+// WasNot creates a "field WAS NOT operand" history clause.
+func (f FieldRef) WasNot(operand Operand) *TerminalClause { return f.clause(WasNot, operand) }
+
+// WasIn creates a "field WAS IN (operands)" history clause.
+func (f FieldRef) WasIn(operands ...Operand) *TerminalClause {
+	return f.clause(WasIn, listOperand(operands))
+}
+
+// WasNotIn creates a "field WAS NOT IN (operands)" history clause.
+func (f FieldRef) WasNotIn(operands ...Operand) *TerminalClause {
+	return f.clause(WasNotIn, listOperand(operands))
+}
+
+// Changed creates a "field CHANGED" history clause.
+func (f FieldRef) Changed() *TerminalClause { return f.clause(Changed, nil) }
+
+// listOperand keeps a single list or function operand as is, e.g. "sprint IN openSprints()".
+func listOperand(operands []Operand) Operand {
+
+	if len(operands) == 1 {
+		switch operands[0].(type) {
+		case List, *Function:
+			return operands[0]
+		}
+	}
+
+	return List(operands)
+}
+
+// And joins the clauses using the AND operator, nil clauses are ignored.
+// It returns nil when all the clauses are nil.
+func And(clauses ...Clause) Clause { return compound("AND", clauses) }
+
+// Or joins the clauses using the OR operator, nil clauses are ignored.
+// It returns nil when all the clauses are nil.
+func Or(clauses ...Clause) Clause { return compound("OR", clauses) }
+
+// Not negates a clause, it returns nil when the clause is nil.
+func Not(clause Clause) Clause {
+
+	if clause == nil {
+		return nil
+	}
+
+	return &NotClause{Clause: clause}
+}
+
+// compound joins the clauses, it returns nil when all the clauses are nil.
+func compound(operator string, clauses []Clause) Clause {
+
+	var filtered []Clause
+	for _, clause := range clauses {
+		if clause != nil {
+			filtered = append(filtered, clause)
+		}
+	}
+
+	switch len(filtered) {
+	case 0:
+		return nil
+	case 1:
+		return filtered[0]
+	}
+
+	return &CompoundClause{Operator: operator, Clauses: filtered}
+}
+
+// Walk rewrites a clause tree bottom-up, replacing every clause with the value returned by fn.
+// Returning nil removes the clause from its parent.
+func Walk(clause Clause, fn func(Clause) Clause) Clause {
+
+	switch typed := clause.(type) {
+	case *CompoundClause:
+		var clauses []Clause
+		for _, nested := range typed.Clauses {
+			if rewritten := Walk(nested, fn); rewritten != nil {
+				clauses = append(clauses, rewritten)
+			}
+		}
+
+		if len(clauses) == 0 {
+			return nil
+		}
+
+		return fn(compound(typed.Operator, clauses))
+
+	case *NotClause:
+		nested := Walk(typed.Clause, fn)
+		if nested == nil {
+			return nil
+		}
+
+		return fn(&NotClause{Clause: nested})
+	}
+
+	if clause == nil {
+		return nil
+	}
+
+	return fn(clause)
+}
+
+var simpleField = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*(\[[A-Za-z0-9_.\-]+\][A-Za-z0-9_.]*)?$`)
+
+// QuoteField renders a field name, quoting it when it contains special characters or is a reserved word.
+func QuoteField(name string) string {
+
+	if simpleField.MatchString(name) && !reservedWords[strings.ToLower(name)] {
+		return name
+	}
+
+	return Quote(name)
+}
+
+// reservedWords contains the JQL reserved words, they must be quoted when used as field names.
+var reservedWords = map[string]bool{}
+
+func init() {
+
+	words := []string{
+		"a", "an", "abort", "access", "add", "after", "alias", "all", "alter", "and", "any", "are", "as", "asc",
+		"at", "audit", "avg", "be", "before", "begin", "between", "boolean", "break", "but", "by", "byte",
+		"catch", "cf", "char", "character", "check", "checkpoint", "collate", "collation", "column", "commit",
+		"connect", "continue", "count", "create", "current", "date", "decimal", "declare", "decrement",
+		"default", "defaults", "define", "delete", "delimiter", "desc", "difference", "distinct", "divide",
+		"do", "double", "drop", "else", "empty", "encoding", "end", "equals", "escape", "exclusive", "exec",
+		"execute", "exists", "explain", "false", "fetch", "file", "field", "first", "float", "for", "from",
+		"function", "go", "goto", "grant", "greater", "group", "having", "identified", "if", "immediate",
+		"in", "increment", "index", "initial", "inner", "inout", "input", "insert", "int", "integer",
+		"intersect", "intersection", "into", "is", "isempty", "isnull", "join", "last", "left", "less",
+		"like", "limit", "lock", "long", "max", "min", "minus", "mode", "modify", "modulo", "more",
+		"multiply", "next", "noaudit", "not", "notin", "nowait", "null", "number", "object", "of", "on",
+		"option", "or", "order", "outer", "output", "power", "previous", "prior", "privileges", "public",
+		"raise", "raw", "remainder", "rename", "resource", "return", "returns", "revoke", "right", "row",
+		"rowid", "rownum", "rows", "select", "session", "set", "share", "size", "sqrt", "start", "strict",
+		"string", "subtract", "sum", "synonym", "table", "then", "to", "trans", "transaction", "trigger",
+		"true", "uid", "union", "unique", "update", "user", "validate", "values", "view", "when",
+		"whenever", "where", "while", "with",
+	}
+
+	for _, word := range words {
+		reservedWords[word] = true
+	}
+}
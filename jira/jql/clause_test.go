@@ -0,0 +1,147 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClauses(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		clause Clause
+		want   string
+	}{
+		{
+			name:   "when the clause compares a field",
+			clause: Field("project").Eq(Value("KP")),
+			want:   `project = "KP"`,
+		},
+		{
+			name:   "when the field name contains spaces",
+			clause: Field("Story Points").Gte(Number(3)),
+			want:   `"Story Points" >= 3`,
+		},
+		{
+			name:   "when the field is a custom field id",
+			clause: Field("cf[10020]").In(Values("A", "B")),
+			want:   `cf[10020] IN ("A", "B")`,
+		},
+		{
+			name:   "when the field name is a reserved word",
+			clause: Field("order").Eq(Value("1")),
+			want:   `"order" = "1"`,
+		},
+		{
+			name:   "when the in clause uses a function",
+			clause: Field("sprint").In(OpenSprints()),
+			want:   `sprint IN openSprints()`,
+		},
+		{
+			name:   "when the in clause uses several operands",
+			clause: Field("assignee").NotIn(Value("a"), CurrentUser()),
+			want:   `assignee NOT IN ("a", currentUser())`,
+		},
+		{
+			name:   "when the clause checks the empty keyword",
+			clause: Field("fixVersion").IsNot(Empty),
+			want:   `fixVersion IS NOT EMPTY`,
+		},
+		{
+			name:   "when the history clause contains predicates",
+			clause: Field("status").Changed().From(Value("To Do")).To(Value("Done")).After(StartOfMonth()),
+			want:   `status CHANGED FROM "To Do" TO "Done" AFTER startOfMonth()`,
+		},
+		{
+			name:   "when the history clause uses the during predicate",
+			clause: Field("status").WasIn(Values("Open")).During(Value("2024/01/01"), Now()).By(CurrentUser()),
+			want:   `status WAS IN ("Open") DURING ("2024/01/01", now()) BY currentUser()`,
+		},
+		{
+			name: "when the clauses are nested",
+			clause: And(
+				Field("project").Eq(Value("KP")),
+				Or(Field("priority").Eq(Value("High")), Field("labels").Eq(Value("urgent"))),
+				Not(Field("status").Eq(Value("Done"))),
+			),
+			want: `project = "KP" AND (priority = "High" OR labels = "urgent") AND NOT status = "Done"`,
+		},
+		{
+			name:   "when a compound clause is negated",
+			clause: Not(Or(Field("labels").Eq(Value("1")), Field("team").Eq(Value("2")))),
+			want:   `NOT (labels = "1" OR team = "2")`,
+		},
+		{
+			name:   "when the compound clause contains nil clauses",
+			clause: And(nil, Field("project").Eq(Value("KP")), nil),
+			want:   `project = "KP"`,
+		},
+		{
+			name:   "when the compound clause contains empty clauses",
+			clause: &CompoundClause{Operator: "AND", Clauses: []Clause{&CompoundClause{}, Field("project").Eq(Value("KP")), &NotClause{}}},
+			want:   `project = "KP"`,
+		},
+		{
+			name:   "when the negated clause is nil",
+			clause: &NotClause{},
+			want:   "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.clause.String())
+		})
+	}
+}
+
+func TestCompound(t *testing.T) {
+
+	assert.Nil(t, And())
+	assert.Nil(t, Or(nil, nil))
+	assert.Nil(t, And(Or(), Not(nil)))
+	assert.Nil(t, Not(nil))
+	assert.Equal(t, "", NewQuery(And(nil)).String())
+}
+
+func TestWalk(t *testing.T) {
+
+	clause := And(
+		Field("project").Eq(Value("KP")),
+		Or(Field("assignee").Eq(Value("a")), Field("assignee").Eq(Value("b"))),
+		Field("status").Eq(Value("Done")),
+	)
+
+	t.Run("when the clauses are rewritten", func(t *testing.T) {
+
+		rewritten := Walk(clause, func(clause Clause) Clause {
+
+			if terminal, ok := clause.(*TerminalClause); ok && terminal.Field == "project" {
+				return Field("project").In(Values("KP", "KP2"))
+			}
+
+			return clause
+		})
+
+		assert.Equal(t, `project IN ("KP", "KP2") AND (assignee = "a" OR assignee = "b") AND status = "Done"`, rewritten.String())
+	})
+
+	t.Run("when the clauses are removed", func(t *testing.T) {
+
+		rewritten := Walk(clause, func(clause Clause) Clause {
+
+			if terminal, ok := clause.(*TerminalClause); ok && terminal.Field == "assignee" {
+				return nil
+			}
+
+			return clause
+		})
+
+		assert.Equal(t, `project = "KP" AND status = "Done"`, rewritten.String())
+	})
+
+	t.Run("when every clause is removed", func(t *testing.T) {
+		assert.Nil(t, Walk(clause, func(Clause) Clause { return nil }))
+	})
+}
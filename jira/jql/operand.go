@@ -0,0 +1,177 @@
+package jql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Operand represents the right side of a JQL clause.
+type Operand interface {
+	String() string
+	operand()
+}
+
+// Value is a string operand, it's always rendered quoted and escaped.
+type Value string
+
+// String renders the value as a quoted JQL string.
+func (v Value) String() string { return Quote(string(v)) }
+
+func (Value) operand() {}
+
+// Number is a numeric operand, it's rendered unquoted.
+type Number float64
+
+// String renders the number as a JQL number.
+func (n Number) String() string { return strconv.FormatFloat(float64(n), 'f', -1, 64) }
+
+func (Number) operand() {}
+
+// Keyword is a JQL keyword operand, such as EMPTY or NULL.
+type Keyword string
+
+const (
+	// Empty is the EMPTY keyword, used with the IS and IS NOT operators.
+	Empty Keyword = "EMPTY"
+	// Null is the NULL keyword, used with the IS and IS NOT operators.
+	Null Keyword = "NULL"
+)
+
+// String renders the keyword.
+func (k Keyword) String() string { return string(k) }
+
+func (Keyword) operand() {}
+
+// List is a list operand, used with the IN and NOT IN operators.
+type List []Operand
+
+// String renders the list between parentheses.
+func (l List) String() string {
+
+	values := make([]string, 0, len(l))
+	for _, value := range l {
+		values = append(values, value.String())
+	}
+
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func (List) operand() {}
+
+// Values creates a list operand of string values.
+func Values(values ...string) List {
+
+	list := make(List, 0, len(values))
+	for _, value := range values {
+		list = append(list, Value(value))
+	}
+
+	return list
+}
+
+// Function is a JQL function operand, e.g. membersOf("jira-users").
+type Function struct {
+	Name      string   // The name of the function.
+	Arguments []string // The arguments of the function, rendered quoted and escaped.
+}
+
+// String renders the function call.
+func (f *Function) String() string {
+
+	arguments := make([]string, 0, len(f.Arguments))
+	for _, argument := range f.Arguments {
+		arguments = append(arguments, Quote(argument))
+	}
+
+	return f.Name + "(" + strings.Join(arguments, ", ") + ")"
+}
+
+func (*Function) operand() {}
+
+// Func creates a function operand.
+func Func(name string, arguments ...string) *Function {
+	return &Function{Name: name, Arguments: arguments}
+}
+
+// CurrentUser creates the currentUser() function operand.
+func CurrentUser() *Function { return Func("currentUser") }
+
+// MembersOf creates the membersOf() function operand.
+func MembersOf(group string) *Function { return Func("membersOf", group) }
+
+// OpenSprints creates the openSprints() function operand.
+func OpenSprints() *Function { return Func("openSprints") }
+
+// ClosedSprints creates the closedSprints() function operand.
+func ClosedSprints() *Function { return Func("closedSprints") }
+
+// FutureSprints creates the futureSprints() function operand.
+func FutureSprints() *Function { return Func("futureSprints") }
+
+// Now creates the now() function operand.
+func Now() *Function { return Func("now") }
+
+// StartOfDay creates the startOfDay() function operand, the optional increment uses the "(+/-)nn(y|M|w|d|h|m)" format.
+func StartOfDay(increment ...string) *Function { return Func("startOfDay", increment...) }
+
+// EndOfDay creates the endOfDay() function operand.
+func EndOfDay(increment ...string) *Function { return Func("endOfDay", increment...) }
+
+// StartOfWeek creates the startOfWeek() function operand.
+func StartOfWeek(increment ...string) *Function { return Func("startOfWeek", increment...) }
+
+// EndOfWeek creates the endOfWeek() function operand.
+func EndOfWeek(increment ...string) *Function { return Func("endOfWeek", increment...) }
+
+// StartOfMonth creates the startOfMonth() function operand.
+func StartOfMonth(increment ...string) *Function { return Func("startOfMonth", increment...) }
+
+// EndOfMonth creates the endOfMonth() function operand.
+func EndOfMonth(increment ...string) *Function { return Func("endOfMonth", increment...) }
+
+// StartOfYear creates the startOfYear() function operand.
+func StartOfYear(increment ...string) *Function { return Func("startOfYear", increment...) }
+
+// EndOfYear creates the endOfYear() function operand.
+func EndOfYear(increment ...string) *Function { return Func("endOfYear", increment...) }
+
+// ReleasedVersions creates the releasedVersions() function operand.
+func ReleasedVersions(project ...string) *Function { return Func("releasedVersions", project...) }
+
+// UnreleasedVersions creates the unreleasedVersions() function operand.
+func UnreleasedVersions(project ...string) *Function { return Func("unreleasedVersions", project...) }
+
+// LinkedIssues creates the linkedIssues() function operand.
+func LinkedIssues(issueKey string, linkType ...string) *Function {
+	return Func("linkedIssues", append([]string{issueKey}, linkType...)...)
+}
+
+// IssueHistory creates the issueHistory() function operand.
+func IssueHistory() *Function { return Func("issueHistory") }
+
+// Quote renders a string as a quoted JQL string, escaping the quotes, backslashes and control characters.
+func Quote(value string) string {
+
+	var builder strings.Builder
+	builder.WriteByte('"')
+
+	for _, character := range value {
+		switch character {
+		case '"':
+			builder.WriteString(`\"`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			builder.WriteRune(character)
+		}
+	}
+
+	builder.WriteByte('"')
+	return builder.String()
+}
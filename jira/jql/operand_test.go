@@ -0,0 +1,73 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperands(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		operand Operand
+		want    string
+	}{
+		{
+			name:    "when the value contains quotes and backslashes",
+			operand: Value(`say "hi" \ bye`),
+			want:    `"say \"hi\" \\ bye"`,
+		},
+		{
+			name:    "when the value contains control characters",
+			operand: Value("line\nbreak\ttab\r"),
+			want:    `"line\nbreak\ttab\r"`,
+		},
+		{
+			name:    "when the value tries to inject a clause",
+			operand: Value(`x" OR project = "SECRET`),
+			want:    `"x\" OR project = \"SECRET"`,
+		},
+		{
+			name:    "when the operand is a number",
+			operand: Number(10.5),
+			want:    "10.5",
+		},
+		{
+			name:    "when the operand is a keyword",
+			operand: Empty,
+			want:    "EMPTY",
+		},
+		{
+			name:    "when the operand is a list",
+			operand: List{Value("KP"), Number(10000), CurrentUser()},
+			want:    `("KP", 10000, currentUser())`,
+		},
+		{
+			name:    "when the operand is a list of values",
+			operand: Values("To Do", "Done"),
+			want:    `("To Do", "Done")`,
+		},
+		{
+			name:    "when the function contains arguments",
+			operand: MembersOf(`jira "admins"`),
+			want:    `membersOf("jira \"admins\"")`,
+		},
+		{
+			name:    "when the function contains an increment",
+			operand: StartOfWeek("-1w"),
+			want:    `startOfWeek("-1w")`,
+		},
+		{
+			name:    "when the linked issues function contains a link type",
+			operand: LinkedIssues("KP-1", "blocks"),
+			want:    `linkedIssues("KP-1", "blocks")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.operand.String())
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package jql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// FromParsedQuery converts the structure returned by the JQL.Parse method into a typed query,
+// so the parsed queries can be inspected, rewritten (see Walk) and rendered again.
+func FromParsedQuery(query *model.ParseQueryScheme) (*Query, error) {
+
+	if query == nil {
+		return nil, model.ErrNoParsedQuery
+	}
+
+	if len(query.Errors) != 0 {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidParsedQuery, strings.Join(query.Errors, ", "))
+	}
+
+	converted := &Query{}
+
+	if query.Structure.Where != nil {
+
+		where, err := fromParsedClause(query.Structure.Where)
+		if err != nil {
+			return nil, err
+		}
+
+		converted.Where = where
+	}
+
+	if query.Structure.OrderBy != nil {
+		for _, field := range query.Structure.OrderBy.Fields {
+
+			if field == nil || field.Field == nil {
+				continue
+			}
+
+			converted.OrderBy(field.Field.Name, Direction(strings.ToUpper(field.Direction)))
+		}
+	}
+
+	return converted, nil
+}
+
+func fromParsedClause(clause *model.QueryClauseScheme) (Clause, error) {
+
+	if clause.Field == nil {
+
+		var clauses []Clause
+		for _, nested := range clause.Clauses {
+
+			converted, err := fromParsedClause(nested)
+			if err != nil {
+				return nil, err
+			}
+
+			clauses = append(clauses, converted)
+		}
+
+		switch strings.ToUpper(clause.Operator) {
+		case "AND", "OR":
+			return &CompoundClause{Operator: strings.ToUpper(clause.Operator), Clauses: clauses}, nil
+
+		case "NOT":
+			if len(clauses) != 1 {
+				return nil, fmt.Errorf("%w: the not clause must contain a single clause", model.ErrInvalidParsedQuery)
+			}
+
+			return &NotClause{Clause: clauses[0]}, nil
+		}
+
+		return nil, fmt.Errorf("%w: unknown compound operator %q", model.ErrInvalidParsedQuery, clause.Operator)
+	}
+
+	terminal := &TerminalClause{
+		Field:    clause.Field.Name,
+		Operator: Operator(strings.ToUpper(clause.Operator)),
+	}
+
+	if clause.Operand != nil {
+		terminal.Operand = fromParsedOperand(clause.Operand)
+	}
+
+	for _, predicate := range clause.Predicates {
+
+		if predicate == nil {
+			continue
+		}
+
+		converted := &Predicate{Operator: strings.ToUpper(predicate.Operator)}
+		if predicate.Operand != nil {
+			converted.Operand = fromParsedOperand(predicate.Operand)
+		}
+
+		terminal.Predicates = append(terminal.Predicates, converted)
+	}
+
+	return terminal, nil
+}
+
+func fromParsedOperand(operand *model.QueryOperandScheme) Operand {
+
+	switch {
+	case operand.Keyword != "":
+		return Keyword(strings.ToUpper(operand.Keyword))
+
+	case operand.Function != "":
+		return Func(operand.Function, operand.Arguments...)
+
+	case operand.Values != nil:
+		list := make(List, 0, len(operand.Values))
+		for _, value := range operand.Values {
+			list = append(list, fromParsedOperand(value))
+		}
+
+		return list
+	}
+
+	// The unquoted numeric values are kept as numbers, e.g. "priority = 3".
+	if operand.EncodedValue != "" && !strings.HasPrefix(operand.EncodedValue, `"`) && !strings.HasPrefix(operand.EncodedValue, "'") {
+		if number, err := strconv.ParseFloat(operand.EncodedValue, 64); err == nil {
+			return Number(number)
+		}
+	}
+
+	return Value(operand.Value)
+}
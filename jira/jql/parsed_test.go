@@ -0,0 +1,100 @@
+package jql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestFromParsedQuery(t *testing.T) {
+
+	parsedMocked := `{
+	  "query": "project = KP AND (priority = 3 OR assignee in (currentUser(), \"a b\")) AND NOT status changed after startOfDay(\"-1d\") AND fixVersion is EMPTY ORDER BY updated desc",
+	  "structure": {
+	    "where": {
+	      "clauses": [
+	        {"field": {"name": "project", "encodedName": "project"}, "operator": "=", "operand": {"value": "KP", "encodedValue": "KP"}},
+	        {
+	          "clauses": [
+	            {"field": {"name": "priority"}, "operator": "=", "operand": {"value": "3", "encodedValue": "3"}},
+	            {"field": {"name": "assignee"}, "operator": "in", "operand": {"values": [
+	              {"function": "currentUser", "arguments": []},
+	              {"value": "a b", "encodedValue": "\"a b\""}
+	            ]}}
+	          ],
+	          "operator": "or"
+	        },
+	        {
+	          "clauses": [
+	            {"field": {"name": "status"}, "operator": "changed", "predicates": [
+	              {"operator": "after", "operand": {"function": "startOfDay", "arguments": ["-1d"]}}
+	            ]}
+	          ],
+	          "operator": "not"
+	        },
+	        {"field": {"name": "fixVersion"}, "operator": "is", "operand": {"keyword": "empty"}}
+	      ],
+	      "operator": "and"
+	    },
+	    "orderBy": {"fields": [{"field": {"name": "updated"}, "direction": "desc"}]}
+	  },
+	  "errors": []
+	}`
+
+	parsed := new(model.ParseQueryScheme)
+	assert.NoError(t, json.Unmarshal([]byte(parsedMocked), parsed))
+
+	testCases := []struct {
+		name    string
+		query   *model.ParseQueryScheme
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:  "when the parsed query contains every kind of clause",
+			query: parsed,
+			want:  `project = "KP" AND (priority = 3 OR assignee IN (currentUser(), "a b")) AND NOT status CHANGED AFTER startOfDay("-1d") AND fixVersion IS EMPTY ORDER BY updated DESC`,
+		},
+		{
+			name:  "when the parsed query does not contain a where clause",
+			query: &model.ParseQueryScheme{},
+			want:  "",
+		},
+		{
+			name:    "when the parsed query contains errors",
+			query:   &model.ParseQueryScheme{Errors: []string{"Error in the JQL Query"}},
+			wantErr: true,
+			Err:     model.ErrInvalidParsedQuery,
+		},
+		{
+			name:    "when the parsed query contains an unknown compound operator",
+			query:   &model.ParseQueryScheme{Structure: model.QueryStructureScheme{Where: &model.QueryClauseScheme{Operator: "xor"}}},
+			wantErr: true,
+			Err:     model.ErrInvalidParsedQuery,
+		},
+		{
+			name:    "when the parsed query is not provided",
+			wantErr: true,
+			Err:     model.ErrNoParsedQuery,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := FromParsedQuery(testCase.query)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got.String())
+		})
+	}
+}
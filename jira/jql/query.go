@@ -0,0 +1,141 @@
+// Package jql provides a typed JQL (Jira Query Language) builder.
+//
+// The queries are built using a small AST (clauses, operands and functions) and rendered with the
+// values and field names correctly quoted and escaped, so user supplied values are safe to use:
+//
+//	query := jql.NewQuery(jql.And(
+//		jql.Field("project").Eq(jql.Value("KP")),
+//		jql.Field("sprint").In(jql.OpenSprints()),
+//		jql.Or(
+//			jql.Field("assignee").In(jql.MembersOf("jira-developers")),
+//			jql.Field("summary").Contains(jql.Value(userInput)),
+//		),
+//	)).OrderBy("updated", jql.Desc)
+//
+//	issues, response, err := client.Issue.Search.SearchJQL(ctx, query.String(), nil, nil, 50, "")
+//
+// The queries can also be built from the structure returned by the JQL.Parse method, see FromParsedQuery.
+//
+// The helper packages read the search pages through a SearchFunc, see Search and Pages:
+//
+//	err := jql.Pages(ctx, jql.Search(client.Issue.Search.SearchJQL), query.String(), nil, nil, 100, func(page gjson.Result) error {
+//		return nil
+//	})
+package jql
+
+import (
+	"fmt"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Direction is the sort direction of an ORDER BY field.
+type Direction string
+
+const (
+	// Asc sorts the field in ascending order.
+	Asc Direction = "ASC"
+	// Desc sorts the field in descending order.
+	Desc Direction = "DESC"
+)
+
+// OrderField represents a field of the ORDER BY section.
+type OrderField struct {
+	Field     string    // The name of the field.
+	Direction Direction // The sort direction, empty to use the field default.
+}
+
+// String renders the order field.
+func (o *OrderField) String() string {
+
+	if o.Direction == "" {
+		return QuoteField(o.Field)
+	}
+
+	return QuoteField(o.Field) + " " + string(o.Direction)
+}
+
+// Query represents a JQL query.
+type Query struct {
+	Where Clause        // The where section, nil to match every issue.
+	Order []*OrderField // The ORDER BY section.
+}
+
+// NewQuery creates a new query with the where section provided.
+func NewQuery(where Clause) *Query {
+	return &Query{Where: where}
+}
+
+// OrderBy appends a field to the ORDER BY section.
+func (q *Query) OrderBy(field string, direction Direction) *Query {
+	q.Order = append(q.Order, &OrderField{Field: field, Direction: direction})
+	return q
+}
+
+// String renders the JQL query.
+func (q *Query) String() string {
+
+	var builder strings.Builder
+
+	if q.Where != nil {
+		builder.WriteString(q.Where.String())
+	}
+
+	if len(q.Order) != 0 {
+
+		if builder.Len() != 0 {
+			builder.WriteString(" ")
+		}
+
+		fields := make([]string, 0, len(q.Order))
+		for _, field := range q.Order {
+			fields = append(fields, field.String())
+		}
+
+		builder.WriteString("ORDER BY ")
+		builder.WriteString(strings.Join(fields, ", "))
+	}
+
+	return builder.String()
+}
+
+// Validate checks the where section, the list clauses (IN, NOT IN, WAS IN and WAS NOT IN) must contain at least one operand.
+func (q *Query) Validate() error {
+	return validateClause(q.Where)
+}
+
+// Build validates and renders the JQL query.
+func (q *Query) Build() (string, error) {
+
+	if err := q.Validate(); err != nil {
+		return "", err
+	}
+
+	return q.String(), nil
+}
+
+func validateClause(clause Clause) error {
+
+	switch typed := clause.(type) {
+	case *CompoundClause:
+		for _, nested := range typed.Clauses {
+			if err := validateClause(nested); err != nil {
+				return err
+			}
+		}
+
+	case *NotClause:
+		return validateClause(typed.Clause)
+
+	case *TerminalClause:
+		switch typed.Operator {
+		case In, NotIn, WasIn, WasNotIn:
+			if list, isList := typed.Operand.(List); typed.Operand == nil || (isList && len(list) == 0) {
+				return fmt.Errorf("%w: %v %v", model.ErrNoJQLOperands, typed.Field, typed.Operator)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestQuery_String(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name: "when the query contains the where and order sections",
+			query: NewQuery(And(
+				Field("project").Eq(Value("KP")),
+				Field("sprint").In(OpenSprints()),
+			)).OrderBy("updated", Desc).OrderBy("Story Points", ""),
+			want: `project = "KP" AND sprint IN openSprints() ORDER BY updated DESC, "Story Points"`,
+		},
+		{
+			name:  "when the query only contains the order section",
+			query: NewQuery(nil).OrderBy("created", Asc),
+			want:  `ORDER BY created ASC`,
+		},
+		{
+			name:  "when the query is empty",
+			query: NewQuery(nil),
+			want:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.query.String())
+		})
+	}
+}
+
+func TestQuery_Build(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		query   *Query
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "when the list clauses contain operands",
+			query: NewQuery(And(Field("status").In(Values("Open")), Not(Field("labels").WasNotIn(Value("a"))))),
+			want:  `status IN ("Open") AND NOT labels WAS NOT IN ("a")`,
+		},
+		{
+			name:    "when the in clause is empty",
+			query:   NewQuery(And(Field("project").Eq(Value("KP")), Field("status").In())),
+			wantErr: true,
+		},
+		{
+			name:    "when the negated not in clause is empty",
+			query:   NewQuery(Not(Field("status").NotIn(Values()...))),
+			wantErr: true,
+		},
+		{
+			name:  "when the query is empty",
+			query: NewQuery(nil),
+			want:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := testCase.query.Build()
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, model.ErrNoJQLOperands)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
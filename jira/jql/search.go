@@ -0,0 +1,52 @@
+package jql
+
+import (
+	"context"
+
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SearchFunc runs a JQL search page, the issues are read from the response body to include every field.
+type SearchFunc func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error)
+
+// Search adapts the SearchJQL method of the v2 or v3 search services, e.g. client.Issue.Search.SearchJQL.
+func Search[T any](searchJQL func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (T, *model.ResponseScheme, error)) SearchFunc {
+
+	return func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+		_, response, err := searchJQL(ctx, jql, fields, expands, maxResults, nextPageToken)
+		return response, err
+	}
+}
+
+// Pages runs the search of a JQL query and calls fn with each page of issues, following the page tokens
+// until the last page. The page size is 100 when zero.
+func Pages(ctx context.Context, search SearchFunc, query string, fields, expands []string, pageSize int, fn func(page gjson.Result) error) error {
+
+	if search == nil {
+		return model.ErrNoSearchFunc
+	}
+
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	for nextPageToken := ""; ; {
+
+		response, err := search(ctx, query, fields, expands, pageSize, nextPageToken)
+		if err != nil {
+			return err
+		}
+
+		page := gjson.ParseBytes(response.Bytes.Bytes())
+		if err = fn(page); err != nil {
+			return err
+		}
+
+		nextPageToken = page.Get("nextPageToken").String()
+		if nextPageToken == "" || !page.Get("issues.0").Exists() {
+			return nil
+		}
+	}
+}
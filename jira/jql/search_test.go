@@ -0,0 +1,60 @@
+package jql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestPages(t *testing.T) {
+
+	pages := map[string]string{
+		"":     `{"issues":[{"key":"KP-1"},{"key":"KP-2"}],"nextPageToken":"next"}`,
+		"next": `{"issues":[{"key":"KP-3"}]}`,
+	}
+
+	var tokens []string
+	var sizes []int
+
+	search := Search(func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.IssueSearchJQLScheme, *model.ResponseScheme, error) {
+
+		if jql == "project = FAIL" {
+			return nil, nil, errors.New("error, request failed. Please fix me")
+		}
+
+		tokens, sizes = append(tokens, nextPageToken), append(sizes, maxResults)
+		return nil, &model.ResponseScheme{Bytes: *bytes.NewBufferString(pages[nextPageToken])}, nil
+	})
+
+	var keys []string
+	err := Pages(context.Background(), search, "project = KP", nil, nil, 0, func(page gjson.Result) error {
+
+		for _, issue := range page.Get("issues").Array() {
+			keys = append(keys, issue.Get("key").String())
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+	assert.Equal(t, []string{"", "next"}, tokens)
+	assert.Equal(t, []int{100, 100}, sizes)
+
+	err = Pages(context.Background(), search, "project = KP", nil, nil, 50, func(page gjson.Result) error {
+		return errors.New("stopped")
+	})
+	assert.EqualError(t, err, "stopped")
+
+	err = Pages(context.Background(), search, "project = FAIL", nil, nil, 50, func(page gjson.Result) error { return nil })
+	assert.EqualError(t, err, "error, request failed. Please fix me")
+
+	err = Pages(context.Background(), nil, "project = KP", nil, nil, 50, func(page gjson.Result) error { return nil })
+	assert.ErrorIs(t, err, model.ErrNoSearchFunc)
+}
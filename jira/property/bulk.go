@@ -0,0 +1,121 @@
+package property
+
+import (
+	"context"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/jql"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// maxBulkEntities is the maximum number of issue IDs of a bulk property update or delete.
+const maxBulkEntities = 10000
+
+// BulkStore sets and deletes the issue properties, e.g. client.EntityProperty.
+type BulkStore interface {
+	BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkSetPayloadScheme) (*model.ResponseScheme, error)
+	BulkDelete(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkDeletePayloadScheme) (*model.ResponseScheme, error)
+}
+
+// Bulk sets and deletes the property of the issues matching a JQL query.
+type Bulk struct {
+	// Search runs the JQL search pages, see jql.Search.
+	Search jql.SearchFunc
+	// Store sets and deletes the properties.
+	Store BulkStore
+	// PageSize is the number of issues fetched per search page, 100 when zero.
+	PageSize int
+}
+
+// SetByJQL sets the value of the property on the issues matching the JQL query, it returns the number of issues.
+//
+// The properties are set asynchronously by Jira, in batches of up to 10000 issues.
+func (b *Bulk) SetByJQL(ctx context.Context, query, propertyKey string, value interface{}) (int, error) {
+
+	if propertyKey == "" {
+		return 0, model.ErrNoPropertyKey
+	}
+
+	issueIDs, err := b.issueIDs(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	for index, batch := range batches(issueIDs) {
+
+		payload := &model.IssuePropertyBulkSetPayloadScheme{
+			Value:  value,
+			Filter: &model.IssuePropertyBulkFilterScheme{EntityIDs: batch},
+		}
+
+		if _, err = b.Store.BulkSet(ctx, propertyKey, payload); err != nil {
+			return index * maxBulkEntities, err
+		}
+	}
+
+	return len(issueIDs), nil
+}
+
+// DeleteByJQL deletes the property from the issues matching the JQL query, it returns the number of issues.
+//
+// The properties are deleted asynchronously by Jira, in batches of up to 10000 issues.
+func (b *Bulk) DeleteByJQL(ctx context.Context, query, propertyKey string) (int, error) {
+
+	if propertyKey == "" {
+		return 0, model.ErrNoPropertyKey
+	}
+
+	issueIDs, err := b.issueIDs(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	for index, batch := range batches(issueIDs) {
+
+		payload := &model.IssuePropertyBulkDeletePayloadScheme{EntityIDs: batch}
+		if _, err = b.Store.BulkDelete(ctx, propertyKey, payload); err != nil {
+			return index * maxBulkEntities, err
+		}
+	}
+
+	return len(issueIDs), nil
+}
+
+// issueIDs returns the IDs of the issues matching a JQL query.
+func (b *Bulk) issueIDs(ctx context.Context, query string) ([]int, error) {
+
+	var issueIDs []int
+
+	// The search returns only the issue IDs when no field is requested.
+	err := jql.Pages(ctx, b.Search, query, nil, nil, b.PageSize, func(page gjson.Result) error {
+
+		for _, value := range page.Get("issues").Array() {
+			issueIDs = append(issueIDs, int(value.Get("id").Int()))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issueIDs, nil
+}
+
+// batches splits the issue IDs in batches of up to maxBulkEntities IDs.
+func batches(issueIDs []int) [][]int {
+
+	var result [][]int
+	for start := 0; start < len(issueIDs); start += maxBulkEntities {
+
+		end := start + maxBulkEntities
+		if end > len(issueIDs) {
+			end = len(issueIDs)
+		}
+
+		result = append(result, issueIDs[start:end])
+	}
+
+	return result
+}
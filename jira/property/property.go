@@ -0,0 +1,81 @@
+// Package property reads and writes typed entity properties, and sets or deletes the property of the issues
+// matching a JQL query.
+//
+// The values of the properties are encoded as JSON, a Property is bound to an entity, a key and a value type:
+//
+//	state := &property.Property[*State]{
+//		Store:  client.EntityProperty,
+//		Entity: &models.PropertyEntityScheme{Kind: models.PropertyEntityApp, ID: "com.example.app"},
+//		Key:    "integration-state",
+//	}
+//
+//	value, ok, err := state.Get(ctx)
+//	err = state.Set(ctx, &State{Cursor: 42})
+//
+// The bulk operations resolve the issue IDs with a JQL search:
+//
+//	bulk := &property.Bulk{Search: jql.Search(client.Issue.Search.SearchJQL), Store: client.EntityProperty}
+//	count, err := bulk.SetByJQL(ctx, "project = KP AND status = Done", "integration-state", map[string]bool{"synced": true})
+package property
+
+import (
+	"context"
+	"errors"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Store reads and writes the entity properties, e.g. client.EntityProperty.
+type Store interface {
+	Get(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error)
+	Set(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string, value interface{}) (*model.ResponseScheme, error)
+	Delete(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.ResponseScheme, error)
+}
+
+// Property represents a property of an entity whose value is of type T.
+type Property[T any] struct {
+	// Store reads and writes the property.
+	Store Store
+	// Entity is the entity storing the property.
+	Entity *model.PropertyEntityScheme
+	// Key is the key of the property.
+	Key string
+}
+
+// Get returns the value of the property, ok is false when the entity has no property with the key.
+func (p *Property[T]) Get(ctx context.Context) (value T, ok bool, err error) {
+
+	property, _, err := p.Store.Get(ctx, p.Entity, p.Key)
+	if err != nil {
+
+		if errors.Is(err, model.ErrNotFound) {
+			return value, false, nil
+		}
+
+		return value, false, err
+	}
+
+	value, err = model.EntityPropertyValue[T](property)
+	if err != nil {
+		return value, false, err
+	}
+
+	return value, true, nil
+}
+
+// Set sets the value of the property.
+func (p *Property[T]) Set(ctx context.Context, value T) error {
+	_, err := p.Store.Set(ctx, p.Entity, p.Key, value)
+	return err
+}
+
+// Delete deletes the property, it succeeds when the entity has no property with the key.
+func (p *Property[T]) Delete(ctx context.Context) error {
+
+	_, err := p.Store.Delete(ctx, p.Entity, p.Key)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
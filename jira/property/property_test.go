@@ -0,0 +1,168 @@
+package property
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type storeMocked struct {
+	values  map[string]interface{}
+	bulk    []*model.IssuePropertyBulkSetPayloadScheme
+	deleted []*model.IssuePropertyBulkDeletePayloadScheme
+}
+
+func (s *storeMocked) Get(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+
+	value, ok := s.values[entity.ID+"/"+propertyKey]
+	if !ok {
+		return nil, &model.ResponseScheme{Code: 404}, model.ErrNotFound
+	}
+
+	return &model.EntityPropertyScheme{Key: propertyKey, Value: value}, nil, nil
+}
+
+func (s *storeMocked) Set(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+
+	s.values[entity.ID+"/"+propertyKey] = value
+	return nil, nil
+}
+
+func (s *storeMocked) Delete(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.ResponseScheme, error) {
+
+	if _, ok := s.values[entity.ID+"/"+propertyKey]; !ok {
+		return nil, model.ErrNotFound
+	}
+
+	delete(s.values, entity.ID+"/"+propertyKey)
+	return nil, nil
+}
+
+func (s *storeMocked) BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkSetPayloadScheme) (*model.ResponseScheme, error) {
+
+	s.bulk = append(s.bulk, payload)
+	return nil, nil
+}
+
+func (s *storeMocked) BulkDelete(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkDeletePayloadScheme) (*model.ResponseScheme, error) {
+
+	if propertyKey == "failed" {
+		return nil, errors.New("error, request failed. Please fix me")
+	}
+
+	s.deleted = append(s.deleted, payload)
+	return nil, nil
+}
+
+func TestProperty(t *testing.T) {
+
+	type state struct {
+		Cursor int `json:"cursor"`
+	}
+
+	store := &storeMocked{values: map[string]interface{}{
+		"com.example.app/invalid": "cursor",
+	}}
+
+	property := &Property[*state]{
+		Store:  store,
+		Entity: &model.PropertyEntityScheme{Kind: model.PropertyEntityApp, ID: "com.example.app"},
+		Key:    "integration-state",
+	}
+
+	value, ok, err := property.Get(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+
+	assert.NoError(t, property.Set(context.Background(), &state{Cursor: 42}))
+
+	value, ok, err = property.Get(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 42, value.Cursor)
+
+	assert.NoError(t, property.Delete(context.Background()))
+	assert.NoError(t, property.Delete(context.Background()))
+
+	property.Key = "invalid"
+	_, _, err = property.Get(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBulk(t *testing.T) {
+
+	pages := map[string]string{
+		"":     `{"issues":[{"id":"10001"},{"id":"10002"}],"nextPageToken":"next"}`,
+		"next": `{"issues":[{"id":"10003"}]}`,
+	}
+
+	search := func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+
+		if jql == "project = FAIL" {
+			return nil, errors.New("error, request failed. Please fix me")
+		}
+
+		return &model.ResponseScheme{Bytes: *bytes.NewBufferString(pages[nextPageToken])}, nil
+	}
+
+	store := &storeMocked{values: map[string]interface{}{"10001/synced": true, "10003/synced": true}}
+	bulk := &Bulk{Search: search, Store: store}
+
+	count, err := bulk.SetByJQL(context.Background(), "project = KP", "synced", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []int{10001, 10002, 10003}, store.bulk[0].Filter.EntityIDs)
+
+	count, err = bulk.DeleteByJQL(context.Background(), "project = KP", "synced")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []*model.IssuePropertyBulkDeletePayloadScheme{{EntityIDs: []int{10001, 10002, 10003}}}, store.deleted)
+
+	_, err = bulk.DeleteByJQL(context.Background(), "project = KP", "failed")
+	assert.EqualError(t, err, "error, request failed. Please fix me")
+
+	_, err = bulk.SetByJQL(context.Background(), "project = FAIL", "synced", true)
+	assert.EqualError(t, err, "error, request failed. Please fix me")
+
+	_, err = bulk.DeleteByJQL(context.Background(), "project = KP", "")
+	assert.ErrorIs(t, err, model.ErrNoPropertyKey)
+
+	_, err = (&Bulk{}).SetByJQL(context.Background(), "project = KP", "synced", true)
+	assert.ErrorIs(t, err, model.ErrNoSearchFunc)
+}
+
+func TestBulk_DeleteByJQL_Batches(t *testing.T) {
+
+	issues := make([]string, maxBulkEntities+1)
+	for index := range issues {
+		issues[index] = `{"id":"` + strconv.Itoa(index+1) + `"}`
+	}
+
+	search := func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+		return &model.ResponseScheme{Bytes: *bytes.NewBufferString(`{"issues":[` + strings.Join(issues, ",") + `]}`)}, nil
+	}
+
+	store := &storeMocked{}
+	count, err := (&Bulk{Search: search, Store: store}).DeleteByJQL(context.Background(), "project = KP", "synced")
+	assert.NoError(t, err)
+	assert.Equal(t, maxBulkEntities+1, count)
+
+	if assert.Len(t, store.deleted, 2) {
+
+		payload, err := json.Marshal(store.deleted[1])
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"entityIds":[10001]}`, string(payload))
+
+		assert.Len(t, store.deleted[0].EntityIDs, maxBulkEntities)
+		assert.Equal(t, 1, store.deleted[0].EntityIDs[0])
+	}
+}
@@ -0,0 +1,387 @@
+package timesheet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/jql"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Dataset represents the worklogs of the issues matching a JQL query, it can be saved and reloaded between refreshes.
+type Dataset struct {
+	JQL      string                    `json:"jql"`      // The JQL query selecting the issues.
+	Since    int64                     `json:"since"`    // The UNIX timestamp, in milliseconds, of the worklog changes already applied.
+	Issues   map[string]*IssueScheme   `json:"issues"`   // The issues, keyed by issue ID.
+	Worklogs map[string]*WorklogScheme `json:"worklogs"` // The worklogs, keyed by worklog ID.
+}
+
+// IssueScheme represents the fields of an issue used to aggregate its worklogs.
+type IssueScheme struct {
+	ID         string   `json:"id"`                   // The ID of the issue.
+	Key        string   `json:"key"`                  // The key of the issue.
+	Project    string   `json:"project"`              // The key of the project of the issue.
+	Parent     string   `json:"parent,omitempty"`     // The key of the parent issue.
+	Epic       string   `json:"epic,omitempty"`       // The key of the epic of the issue, the issue key for the epics.
+	Components []string `json:"components,omitempty"` // The names of the components of the issue.
+}
+
+// WorklogScheme represents a worklog of the dataset.
+type WorklogScheme struct {
+	ID              string    `json:"id"`                        // The ID of the worklog.
+	IssueID         string    `json:"issueId"`                   // The ID of the issue of the worklog.
+	AuthorAccountID string    `json:"authorAccountId,omitempty"` // The account ID of the author.
+	Author          string    `json:"author,omitempty"`          // The display name of the author.
+	Started         time.Time `json:"started"`                   // The time the work started.
+	Seconds         int       `json:"seconds"`                   // The time spent, in seconds.
+}
+
+// LoadDataset reads a dataset saved as JSON by Save.
+func LoadDataset(r io.Reader) (*Dataset, error) {
+
+	dataset := new(Dataset)
+	if err := json.NewDecoder(r).Decode(dataset); err != nil {
+		return nil, err
+	}
+
+	if dataset.Issues == nil {
+		dataset.Issues = make(map[string]*IssueScheme)
+	}
+
+	if dataset.Worklogs == nil {
+		dataset.Worklogs = make(map[string]*WorklogScheme)
+	}
+
+	return dataset, nil
+}
+
+// Save writes the dataset as JSON.
+func (d *Dataset) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(d)
+}
+
+// Collector builds and refreshes the worklog datasets.
+type Collector struct {
+	// Search runs the JQL search pages, see jql.Search.
+	Search jql.SearchFunc
+	// IssueWorklogs lists the worklogs of an issue, see IssueWorklogsV3 and IssueWorklogsV2.
+	IssueWorklogs IssueWorklogsFunc
+	// Worklogs returns the worklogs updated since the previous refresh, see WorklogsV3 and WorklogsV2.
+	Worklogs WorklogsFunc
+	// Changes fetches the worklogs updated and deleted since the previous refresh, e.g. client.Issue.Worklog.
+	Changes ChangeFetcher
+	// PageSize is the number of issues fetched per search page, 100 when zero.
+	PageSize int
+}
+
+// Build fetches the issues matching the JQL query and their worklogs.
+func (c *Collector) Build(ctx context.Context, query string) (*Dataset, error) {
+
+	if c.Search == nil {
+		return nil, model.ErrNoSearchFunc
+	}
+
+	if c.IssueWorklogs == nil {
+		return nil, model.ErrNoWorklogsFunc
+	}
+
+	dataset := &Dataset{
+		JQL:      query,
+		Since:    time.Now().UnixMilli(),
+		Issues:   make(map[string]*IssueScheme),
+		Worklogs: make(map[string]*WorklogScheme),
+	}
+
+	issues, err := c.searchIssues(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+
+		dataset.Issues[issue.ID] = issue
+
+		for startAt := 0; ; {
+
+			worklogs, total, err := c.IssueWorklogs(ctx, issue.ID, startAt, 1000)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, worklog := range worklogs {
+
+				if worklog.IssueID == "" {
+					worklog.IssueID = issue.ID
+				}
+
+				dataset.Worklogs[worklog.ID] = worklog
+			}
+
+			startAt += len(worklogs)
+			if len(worklogs) == 0 || startAt >= total {
+				break
+			}
+		}
+	}
+
+	if err = c.resolveEpics(ctx, dataset, issues); err != nil {
+		return nil, err
+	}
+
+	return dataset, nil
+}
+
+// Refresh applies the worklogs updated and deleted since the previous build or refresh.
+//
+// The worklogs of the issues not yet in the dataset are added when the issues match the JQL query.
+// The fields of the issues already in the dataset, e.g. their epic or components, are not refreshed.
+func (c *Collector) Refresh(ctx context.Context, dataset *Dataset) error {
+
+	switch {
+	case c.Changes == nil:
+		return model.ErrNoWorklogChanges
+	case c.Worklogs == nil:
+		return model.ErrNoWorklogsFunc
+	case c.Search == nil:
+		return model.ErrNoSearchFunc
+	}
+
+	updatedIDs, updatedUntil, err := changedWorklogs(ctx, dataset.Since, func(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, error) {
+		page, _, err := c.Changes.Updated(ctx, since, nil)
+		return page, err
+	})
+	if err != nil {
+		return err
+	}
+
+	deletedIDs, deletedUntil, err := changedWorklogs(ctx, dataset.Since, func(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, error) {
+		page, _, err := c.Changes.Deleted(ctx, since)
+		return page, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var updated []*WorklogScheme
+	for start := 0; start < len(updatedIDs); start += 1000 {
+
+		end := start + 1000
+		if end > len(updatedIDs) {
+			end = len(updatedIDs)
+		}
+
+		worklogs, err := c.Worklogs(ctx, updatedIDs[start:end])
+		if err != nil {
+			return err
+		}
+
+		updated = append(updated, worklogs...)
+	}
+
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, worklog := range updated {
+
+		if _, ok := dataset.Issues[worklog.IssueID]; !ok && !seen[worklog.IssueID] {
+			unknown, seen[worklog.IssueID] = append(unknown, worklog.IssueID), true
+		}
+	}
+
+	var added []*IssueScheme
+	for start := 0; start < len(unknown); start += 100 {
+
+		end := start + 100
+		if end > len(unknown) {
+			end = len(unknown)
+		}
+
+		query := "id in (" + strings.Join(unknown[start:end], ",") + ")"
+		if scope := withoutOrderBy(dataset.JQL); scope != "" {
+			query += " AND (" + scope + ")"
+		}
+
+		issues, err := c.searchIssues(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range issues {
+			dataset.Issues[issue.ID] = issue
+		}
+
+		added = append(added, issues...)
+	}
+
+	for _, worklog := range updated {
+
+		// The worklogs moved to an issue out of the scope are removed.
+		if _, ok := dataset.Issues[worklog.IssueID]; !ok {
+			delete(dataset.Worklogs, worklog.ID)
+			continue
+		}
+
+		dataset.Worklogs[worklog.ID] = worklog
+	}
+
+	for _, worklogID := range deletedIDs {
+		delete(dataset.Worklogs, strconv.Itoa(worklogID))
+	}
+
+	if err = c.resolveEpics(ctx, dataset, added); err != nil {
+		return err
+	}
+
+	// The next refresh starts from the earliest timestamp reached, the changes applied twice are idempotent.
+	dataset.Since = min64(updatedUntil, deletedUntil)
+
+	return nil
+}
+
+// changedWorklogs returns the IDs of the changed worklogs and the timestamp of the last page.
+func changedWorklogs(ctx context.Context, since int64, fetch func(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, error)) ([]int, int64, error) {
+
+	var ids []int
+	until := since
+
+	for {
+
+		page, err := fetch(ctx, int(until))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, worklog := range page.Values {
+			ids = append(ids, worklog.WorklogID)
+		}
+
+		if int64(page.Until) > until {
+			until = int64(page.Until)
+		}
+
+		if page.LastPage || len(page.Values) == 0 {
+			return ids, until, nil
+		}
+	}
+}
+
+// searchIssues returns the issues matching a JQL query, using the fields needed to aggregate the worklogs.
+func (c *Collector) searchIssues(ctx context.Context, query string) ([]*IssueScheme, error) {
+
+	fields := []string{"project", "parent", "issuetype", "components"}
+
+	var issues []*IssueScheme
+	err := jql.Pages(ctx, c.Search, query, fields, nil, c.PageSize, func(page gjson.Result) error {
+
+		for _, value := range page.Get("issues").Array() {
+
+			issue := &IssueScheme{
+				ID:      value.Get("id").String(),
+				Key:     value.Get("key").String(),
+				Project: value.Get("fields.project.key").String(),
+				Parent:  value.Get("fields.parent.key").String(),
+			}
+
+			for _, component := range value.Get("fields.components").Array() {
+				issue.Components = append(issue.Components, component.Get("name").String())
+			}
+
+			// The epics are at the level 1 of the hierarchy, the standard issues at the level 0 and the subtasks at -1.
+			switch level := value.Get("fields.issuetype.hierarchyLevel"); {
+			case level.Exists() && level.Int() == 1:
+				issue.Epic = issue.Key
+			case level.Exists() && level.Int() == 0:
+				issue.Epic = issue.Parent
+			case value.Get("fields.issuetype.subtask").Bool() || level.Exists() && level.Int() == -1:
+				// Resolved by resolveEpics, using the parent of the parent issue.
+			case !level.Exists():
+				issue.Epic = issue.Parent
+			}
+
+			issues = append(issues, issue)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+// resolveEpics sets the epics of the subtasks, using the parents of their parent issues.
+func (c *Collector) resolveEpics(ctx context.Context, dataset *Dataset, issues []*IssueScheme) error {
+
+	byKey := make(map[string]*IssueScheme, len(dataset.Issues))
+	for _, issue := range dataset.Issues {
+		byKey[issue.Key] = issue
+	}
+
+	var missing []string
+	seen := make(map[string]bool)
+	for _, issue := range issues {
+
+		if issue.Epic != "" || issue.Parent == "" {
+			continue
+		}
+
+		if _, ok := byKey[issue.Parent]; !ok && !seen[issue.Parent] {
+			missing, seen[issue.Parent] = append(missing, issue.Parent), true
+		}
+	}
+
+	for start := 0; start < len(missing); start += 100 {
+
+		end := start + 100
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		parents, err := c.searchIssues(ctx, "key in ("+strings.Join(missing[start:end], ",")+")")
+		if err != nil {
+			return err
+		}
+
+		for _, parent := range parents {
+			byKey[parent.Key] = parent
+		}
+	}
+
+	for _, issue := range issues {
+
+		if issue.Epic != "" || issue.Parent == "" {
+			continue
+		}
+
+		if parent, ok := byKey[issue.Parent]; ok {
+			issue.Epic = parent.Epic
+		}
+	}
+
+	return nil
+}
+
+// withoutOrderBy removes the ORDER BY clause of a JQL query, so it can be combined with other clauses.
+func withoutOrderBy(query string) string {
+
+	if index := strings.Index(strings.ToLower(query), "order by"); index != -1 {
+		query = query[:index]
+	}
+
+	return strings.TrimSpace(query)
+}
+
+func min64(a, b int64) int64 {
+
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,213 @@
+package timesheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The dimensions used to group the worklogs.
+const (
+	ByAuthor    = "author"    // The display name of the worklog author.
+	ByProject   = "project"   // The key of the project of the issue.
+	ByEpic      = "epic"      // The key of the epic of the issue, empty for the issues without epic.
+	ByComponent = "component" // The name of each component of the issue, empty for the issues without component.
+	ByIssue     = "issue"     // The key of the issue.
+)
+
+// The periods used to group the worklogs, by their start time.
+const (
+	PeriodDay   = "day"   // Formatted as 2006-01-02.
+	PeriodWeek  = "week"  // The ISO week, formatted as 2006-W01.
+	PeriodMonth = "month" // Formatted as 2006-01.
+)
+
+// AggregateOptions represents the options used to aggregate the worklogs of a dataset.
+type AggregateOptions struct {
+	// GroupBy contains the dimensions of the rows, e.g. ByAuthor and ByProject.
+	// The worklogs of the issues with several components are counted once per component.
+	GroupBy []string
+	// Period groups the worklogs by day, week or month. The worklogs aren't grouped by period when it's empty.
+	Period string
+	// From and To filter the worklogs started in [From, To), they're ignored when zero.
+	From, To time.Time
+	// Location is the time zone of the periods, UTC when nil.
+	Location *time.Location
+}
+
+// RowScheme represents the time logged for a period and group.
+type RowScheme struct {
+	Period   string   `json:"period,omitempty"` // The period, empty when the worklogs aren't grouped by period.
+	Groups   []string `json:"groups"`           // The values of the dimensions, in the order of the GroupBy options.
+	Seconds  int      `json:"seconds"`          // The time logged, in seconds.
+	Worklogs int      `json:"worklogs"`         // The number of worklogs.
+}
+
+// Hours returns the time logged as hours.
+func (r *RowScheme) Hours() float64 {
+	return float64(r.Seconds) / 3600
+}
+
+// ReportScheme represents the aggregated worklogs of a dataset.
+type ReportScheme struct {
+	GroupBy []string     `json:"groupBy"`          // The dimensions of the rows.
+	Period  string       `json:"period,omitempty"` // The period of the rows.
+	Rows    []*RowScheme `json:"rows"`             // The rows, sorted by period and groups.
+}
+
+// Aggregate sums the time logged by the worklogs of the dataset, grouped by period and dimensions.
+func (d *Dataset) Aggregate(options *AggregateOptions) *ReportScheme {
+
+	if options == nil {
+		options = &AggregateOptions{}
+	}
+
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	rows := make(map[string]*RowScheme)
+	for _, worklog := range d.Worklogs {
+
+		if !options.From.IsZero() && worklog.Started.Before(options.From) {
+			continue
+		}
+
+		if !options.To.IsZero() && !worklog.Started.Before(options.To) {
+			continue
+		}
+
+		issue := d.Issues[worklog.IssueID]
+		if issue == nil {
+			issue = &IssueScheme{ID: worklog.IssueID}
+		}
+
+		period := formatPeriod(worklog.Started.In(location), options.Period)
+		if worklog.Started.IsZero() {
+			period = ""
+		}
+
+		for _, groups := range groupValues(worklog, issue, options.GroupBy) {
+
+			key := period + "\x00" + strings.Join(groups, "\x00")
+
+			row, ok := rows[key]
+			if !ok {
+				row = &RowScheme{Period: period, Groups: groups}
+				rows[key] = row
+			}
+
+			row.Seconds += worklog.Seconds
+			row.Worklogs++
+		}
+	}
+
+	report := &ReportScheme{GroupBy: options.GroupBy, Period: options.Period, Rows: make([]*RowScheme, 0, len(rows))}
+	for _, row := range rows {
+		report.Rows = append(report.Rows, row)
+	}
+
+	sort.Slice(report.Rows, func(i, j int) bool {
+
+		if report.Rows[i].Period != report.Rows[j].Period {
+			return report.Rows[i].Period < report.Rows[j].Period
+		}
+
+		return strings.Join(report.Rows[i].Groups, "\x00") < strings.Join(report.Rows[j].Groups, "\x00")
+	})
+
+	return report
+}
+
+// WriteCSV writes a row per period and group, including a header row. The time logged is written as hours.
+func (r *ReportScheme) WriteCSV(w io.Writer) error {
+
+	writer := csv.NewWriter(w)
+
+	var header []string
+	if r.Period != "" {
+		header = append(header, r.Period)
+	}
+
+	header = append(append(header, r.GroupBy...), "hours", "worklogs")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range r.Rows {
+
+		var record []string
+		if r.Period != "" {
+			record = append(record, row.Period)
+		}
+
+		record = append(append(record, row.Groups...), strconv.FormatFloat(row.Hours(), 'f', 2, 64), strconv.Itoa(row.Worklogs))
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// groupValues returns the values of the dimensions of a worklog, a combination per component of the issue.
+func groupValues(worklog *WorklogScheme, issue *IssueScheme, dimensions []string) [][]string {
+
+	combinations := [][]string{make([]string, 0, len(dimensions))}
+	for _, dimension := range dimensions {
+
+		var values []string
+		switch dimension {
+		case ByAuthor:
+			values = []string{worklog.Author}
+			if worklog.Author == "" {
+				values = []string{worklog.AuthorAccountID}
+			}
+		case ByProject:
+			values = []string{issue.Project}
+		case ByEpic:
+			values = []string{issue.Epic}
+		case ByIssue:
+			values = []string{issue.Key}
+		case ByComponent:
+			values = issue.Components
+			if len(values) == 0 {
+				values = []string{""}
+			}
+		default:
+			values = []string{""}
+		}
+
+		next := make([][]string, 0, len(combinations)*len(values))
+		for _, combination := range combinations {
+			for _, value := range values {
+				next = append(next, append(append(make([]string, 0, len(dimensions)), combination...), value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
+func formatPeriod(started time.Time, period string) string {
+
+	switch period {
+	case PeriodDay:
+		return started.Format("2006-01-02")
+	case PeriodWeek:
+		year, week := started.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	case PeriodMonth:
+		return started.Format("2006-01")
+	}
+
+	return ""
+}
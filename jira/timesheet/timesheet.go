@@ -0,0 +1,133 @@
+// Package timesheet builds a local dataset of Jira worklogs and aggregates the time logged
+// by author, project, epic, component and period.
+//
+// The dataset is built from the worklogs of the issues returned by a JQL search, it's refreshed
+// incrementally using the updated and deleted worklogs since the previous refresh, so it can be saved
+// and reloaded between runs:
+//
+//	collector := &timesheet.Collector{
+//		Search:        jql.Search(client.Issue.Search.SearchJQL),
+//		IssueWorklogs: timesheet.IssueWorklogsV3(client.Issue.Worklog.Issue),
+//		Worklogs:      timesheet.WorklogsV3(client.Issue.Worklog.Gets),
+//		Changes:       client.Issue.Worklog,
+//	}
+//
+//	dataset, err := collector.Build(ctx, "project = KP")
+//	err = collector.Refresh(ctx, dataset)
+//
+//	report := dataset.Aggregate(&timesheet.AggregateOptions{GroupBy: []string{timesheet.ByAuthor}, Period: timesheet.PeriodWeek})
+//	err = report.WriteCSV(os.Stdout)
+package timesheet
+
+import (
+	"context"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// startedLayout is the layout of the worklog start times returned by Jira.
+const startedLayout = "2006-01-02T15:04:05.000-0700"
+
+// IssueWorklogsFunc returns a page of the worklogs of an issue, along with the total number of worklogs.
+type IssueWorklogsFunc func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*WorklogScheme, int, error)
+
+// IssueWorklogsV3 adapts the Issue method of the v3 worklog service, e.g. client.Issue.Worklog.Issue.
+func IssueWorklogsV3(issue func(ctx context.Context, issueKeyOrID string, startAt, maxResults, after int, expand []string) (*model.IssueWorklogADFPageScheme, *model.ResponseScheme, error)) IssueWorklogsFunc {
+
+	return func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*WorklogScheme, int, error) {
+
+		page, _, err := issue(ctx, issueKeyOrID, startAt, maxResults, 0, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		worklogs := make([]*WorklogScheme, 0, len(page.Worklogs))
+		for _, worklog := range page.Worklogs {
+			worklogs = append(worklogs, newWorklog(worklog.ID, worklog.IssueID, worklog.Author, worklog.Started, worklog.TimeSpentSeconds))
+		}
+
+		return worklogs, page.Total, nil
+	}
+}
+
+// IssueWorklogsV2 adapts the Issue method of the v2 worklog service, e.g. client.Issue.Worklog.Issue.
+func IssueWorklogsV2(issue func(ctx context.Context, issueKeyOrID string, startAt, maxResults, after int, expand []string) (*model.IssueWorklogRichTextPageScheme, *model.ResponseScheme, error)) IssueWorklogsFunc {
+
+	return func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*WorklogScheme, int, error) {
+
+		page, _, err := issue(ctx, issueKeyOrID, startAt, maxResults, 0, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		worklogs := make([]*WorklogScheme, 0, len(page.Worklogs))
+		for _, worklog := range page.Worklogs {
+			worklogs = append(worklogs, newWorklog(worklog.ID, worklog.IssueID, worklog.Author, worklog.Started, worklog.TimeSpentSeconds))
+		}
+
+		return worklogs, page.Total, nil
+	}
+}
+
+// WorklogsFunc returns the worklogs of up to 1000 worklog IDs.
+type WorklogsFunc func(ctx context.Context, worklogIDs []int) ([]*WorklogScheme, error)
+
+// WorklogsV3 adapts the Gets method of the v3 worklog service, e.g. client.Issue.Worklog.Gets.
+func WorklogsV3(gets func(ctx context.Context, worklogIDs []int, expand []string) ([]*model.IssueWorklogADFScheme, *model.ResponseScheme, error)) WorklogsFunc {
+
+	return func(ctx context.Context, worklogIDs []int) ([]*WorklogScheme, error) {
+
+		result, _, err := gets(ctx, worklogIDs, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		worklogs := make([]*WorklogScheme, 0, len(result))
+		for _, worklog := range result {
+			worklogs = append(worklogs, newWorklog(worklog.ID, worklog.IssueID, worklog.Author, worklog.Started, worklog.TimeSpentSeconds))
+		}
+
+		return worklogs, nil
+	}
+}
+
+// WorklogsV2 adapts the Gets method of the v2 worklog service, e.g. client.Issue.Worklog.Gets.
+func WorklogsV2(gets func(ctx context.Context, worklogIDs []int, expand []string) ([]*model.IssueWorklogRichTextScheme, *model.ResponseScheme, error)) WorklogsFunc {
+
+	return func(ctx context.Context, worklogIDs []int) ([]*WorklogScheme, error) {
+
+		result, _, err := gets(ctx, worklogIDs, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		worklogs := make([]*WorklogScheme, 0, len(result))
+		for _, worklog := range result {
+			worklogs = append(worklogs, newWorklog(worklog.ID, worklog.IssueID, worklog.Author, worklog.Started, worklog.TimeSpentSeconds))
+		}
+
+		return worklogs, nil
+	}
+}
+
+// ChangeFetcher fetches the IDs of the worklogs updated or deleted since a timestamp,
+// it's implemented by the v2 and v3 worklog services, e.g. client.Issue.Worklog.
+type ChangeFetcher interface {
+	Updated(ctx context.Context, since int, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error)
+	Deleted(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error)
+}
+
+func newWorklog(id, issueID string, author *model.UserDetailScheme, started string, seconds int) *WorklogScheme {
+
+	worklog := &WorklogScheme{ID: id, IssueID: issueID, Seconds: seconds}
+
+	if author != nil {
+		worklog.AuthorAccountID, worklog.Author = author.AccountID, author.DisplayName
+	}
+
+	// The start time is kept as zero when it can't be parsed, the worklog is still counted without period.
+	worklog.Started, _ = time.Parse(startedLayout, started)
+
+	return worklog
+}
@@ -0,0 +1,245 @@
+package timesheet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/jira/jql"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type changesMocked struct {
+	updated, deleted []*model.ChangedWorklogPageScheme
+	since            []int
+}
+
+func (c *changesMocked) Updated(ctx context.Context, since int, expand []string) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
+
+	c.since = append(c.since, since)
+	page := c.updated[0]
+	c.updated = c.updated[1:]
+
+	return page, nil, nil
+}
+
+func (c *changesMocked) Deleted(ctx context.Context, since int) (*model.ChangedWorklogPageScheme, *model.ResponseScheme, error) {
+
+	page := c.deleted[0]
+	c.deleted = c.deleted[1:]
+
+	return page, nil, nil
+}
+
+func searchMocked(t *testing.T, results map[string]string) jql.SearchFunc {
+
+	return func(ctx context.Context, jql string, fields, expands []string, maxResults int, nextPageToken string) (*model.ResponseScheme, error) {
+
+		body, ok := results[jql]
+		if !ok {
+			t.Fatalf("unexpected search: %v", jql)
+		}
+
+		return &model.ResponseScheme{Bytes: *bytes.NewBufferString(body)}, nil
+	}
+}
+
+func started(value string) time.Time {
+
+	date, _ := time.Parse(time.RFC3339, value)
+	return date
+}
+
+func TestCollector_Build(t *testing.T) {
+
+	collector := &Collector{
+		Search: searchMocked(t, map[string]string{
+			"project = KP": `{"issues":[
+				{"id":"1","key":"KP-1","fields":{"project":{"key":"KP"},"issuetype":{"hierarchyLevel":1}}},
+				{"id":"2","key":"KP-2","fields":{"project":{"key":"KP"},"parent":{"key":"KP-1"},"issuetype":{"hierarchyLevel":0},"components":[{"name":"API"},{"name":"UI"}]}},
+				{"id":"3","key":"KP-3","fields":{"project":{"key":"KP"},"parent":{"key":"KP-9"},"issuetype":{"hierarchyLevel":-1,"subtask":true}}}]}`,
+			"key in (KP-9)": `{"issues":[{"id":"9","key":"KP-9","fields":{"project":{"key":"KP"},"parent":{"key":"KP-5"},"issuetype":{"hierarchyLevel":0}}}]}`,
+		}),
+		IssueWorklogs: func(ctx context.Context, issueKeyOrID string, startAt, maxResults int) ([]*WorklogScheme, int, error) {
+
+			if issueKeyOrID != "2" {
+				return nil, 0, nil
+			}
+
+			// The worklogs are returned on two pages.
+			worklog := &WorklogScheme{ID: strconv.Itoa(100 + startAt), Seconds: 3600}
+			return []*WorklogScheme{worklog}, 2, nil
+		},
+	}
+
+	dataset, err := collector.Build(context.Background(), "project = KP")
+	assert.NoError(t, err)
+	assert.NotZero(t, dataset.Since)
+
+	assert.Equal(t, "KP-1", dataset.Issues["1"].Epic)
+	assert.Equal(t, "KP-1", dataset.Issues["2"].Epic)
+	assert.Equal(t, "KP-5", dataset.Issues["3"].Epic)
+	assert.Len(t, dataset.Worklogs, 2)
+	assert.Equal(t, "2", dataset.Worklogs["101"].IssueID)
+
+	_, err = (&Collector{}).Build(context.Background(), "project = KP")
+	assert.ErrorIs(t, err, model.ErrNoSearchFunc)
+}
+
+func TestCollector_Refresh(t *testing.T) {
+
+	dataset := &Dataset{
+		JQL:   "project = KP ORDER BY key",
+		Since: 1000,
+		Issues: map[string]*IssueScheme{
+			"1": {ID: "1", Key: "KP-1", Project: "KP"},
+		},
+		Worklogs: map[string]*WorklogScheme{
+			"100": {ID: "100", IssueID: "1", Seconds: 60},
+			"101": {ID: "101", IssueID: "1", Seconds: 60},
+			"102": {ID: "102", IssueID: "1", Seconds: 60},
+		},
+	}
+
+	changes := &changesMocked{
+		updated: []*model.ChangedWorklogPageScheme{
+			{Until: 2000, Values: []*model.ChangedWorklogScheme{{WorklogID: 100}, {WorklogID: 200}}},
+			{Until: 3000, LastPage: true, Values: []*model.ChangedWorklogScheme{{WorklogID: 300}, {WorklogID: 102}}},
+		},
+		deleted: []*model.ChangedWorklogPageScheme{
+			{Until: 2500, LastPage: true, Values: []*model.ChangedWorklogScheme{{WorklogID: 101}}},
+		},
+	}
+
+	collector := &Collector{
+		Search: searchMocked(t, map[string]string{
+			"id in (2,3) AND (project = KP)": `{"issues":[{"id":"2","key":"KP-2","fields":{"project":{"key":"KP"}}}]}`,
+		}),
+		Worklogs: func(ctx context.Context, worklogIDs []int) ([]*WorklogScheme, error) {
+
+			assert.Equal(t, []int{100, 200, 300, 102}, worklogIDs)
+			return []*WorklogScheme{
+				{ID: "100", IssueID: "1", Seconds: 120},
+				{ID: "200", IssueID: "2", Seconds: 60},
+				{ID: "300", IssueID: "3", Seconds: 60},
+				{ID: "102", IssueID: "3", Seconds: 60},
+			}, nil
+		},
+		Changes: changes,
+	}
+
+	assert.NoError(t, collector.Refresh(context.Background(), dataset))
+
+	assert.Equal(t, []int{1000, 2000}, changes.since)
+	assert.Equal(t, int64(2500), dataset.Since)
+	assert.Equal(t, 120, dataset.Worklogs["100"].Seconds)
+	assert.Contains(t, dataset.Worklogs, "200")
+	assert.NotContains(t, dataset.Worklogs, "101")
+	assert.NotContains(t, dataset.Worklogs, "102")
+	assert.NotContains(t, dataset.Worklogs, "300")
+	assert.Contains(t, dataset.Issues, "2")
+
+	assert.ErrorIs(t, (&Collector{}).Refresh(context.Background(), dataset), model.ErrNoWorklogChanges)
+}
+
+func TestDataset_Aggregate(t *testing.T) {
+
+	dataset := &Dataset{
+		Issues: map[string]*IssueScheme{
+			"1": {ID: "1", Key: "KP-1", Project: "KP", Epic: "KP-10", Components: []string{"API", "UI"}},
+			"2": {ID: "2", Key: "OP-1", Project: "OP"},
+		},
+		Worklogs: map[string]*WorklogScheme{
+			"1": {ID: "1", IssueID: "1", Author: "Jane", Started: started("2024-01-29T09:00:00Z"), Seconds: 3600},
+			"2": {ID: "2", IssueID: "1", Author: "Jane", Started: started("2024-02-02T09:00:00Z"), Seconds: 1800},
+			"3": {ID: "3", IssueID: "2", AuthorAccountID: "acc-1", Started: started("2024-02-05T09:00:00Z"), Seconds: 7200},
+			"4": {ID: "4", IssueID: "2", Author: "Jane", Started: started("2023-12-31T09:00:00Z"), Seconds: 7200},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		options *AggregateOptions
+		want    string
+	}{
+		{
+			name:    "when the worklogs are grouped by author and week",
+			options: &AggregateOptions{GroupBy: []string{ByAuthor}, Period: PeriodWeek, From: started("2024-01-01T00:00:00Z")},
+			want:    "week,author,hours,worklogs\n2024-W05,Jane,1.50,2\n2024-W06,acc-1,2.00,1\n",
+		},
+
+		{
+			name:    "when the worklogs are grouped by project and component",
+			options: &AggregateOptions{GroupBy: []string{ByProject, ByComponent}, To: started("2024-02-03T00:00:00Z")},
+			want:    "project,component,hours,worklogs\nKP,API,1.50,2\nKP,UI,1.50,2\nOP,,2.00,1\n",
+		},
+
+		{
+			name:    "when the worklogs are grouped by epic and month",
+			options: &AggregateOptions{GroupBy: []string{ByEpic}, Period: PeriodMonth},
+			want:    "month,epic,hours,worklogs\n2023-12,,2.00,1\n2024-01,KP-10,1.00,1\n2024-02,,2.00,1\n2024-02,KP-10,0.50,1\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var buffer bytes.Buffer
+			assert.NoError(t, dataset.Aggregate(testCase.options).WriteCSV(&buffer))
+			assert.Equal(t, testCase.want, buffer.String())
+		})
+	}
+}
+
+func TestDataset_Save(t *testing.T) {
+
+	dataset := &Dataset{
+		JQL:      "project = KP",
+		Since:    1000,
+		Issues:   map[string]*IssueScheme{"1": {ID: "1", Key: "KP-1"}},
+		Worklogs: map[string]*WorklogScheme{"1": {ID: "1", IssueID: "1", Started: started("2024-01-29T09:00:00Z"), Seconds: 60}},
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, dataset.Save(&buffer))
+
+	loaded, err := LoadDataset(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, dataset, loaded)
+
+	_, err = LoadDataset(strings.NewReader("{"))
+	assert.Error(t, err)
+}
+
+func TestIssueWorklogsV3(t *testing.T) {
+
+	issue := IssueWorklogsV3(func(ctx context.Context, issueKeyOrID string, startAt, maxResults, after int, expand []string) (*model.IssueWorklogADFPageScheme, *model.ResponseScheme, error) {
+
+		if issueKeyOrID == "KP-2" {
+			return nil, nil, errors.New("error, request failed. Please fix me")
+		}
+
+		return &model.IssueWorklogADFPageScheme{Total: 1, Worklogs: []*model.IssueWorklogADFScheme{{
+			ID:               "1",
+			IssueID:          "10",
+			Author:           &model.UserDetailScheme{AccountID: "acc-1", DisplayName: "Jane"},
+			Started:          "2024-01-29T09:00:00.000+0100",
+			TimeSpentSeconds: 3600,
+		}}}, nil, nil
+	})
+
+	worklogs, total, err := issue(context.Background(), "KP-1", 0, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, "Jane", worklogs[0].Author)
+	assert.True(t, worklogs[0].Started.Equal(started("2024-01-29T08:00:00Z")))
+
+	_, _, err = issue(context.Background(), "KP-2", 0, 1000)
+	assert.EqualError(t, err, "error, request failed. Please fix me")
+}
@@ -57,7 +57,12 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	dashboardService, err := internal.NewDashboardService(client, APIVersion)
+	dashboardGadgetService, err := internal.NewDashboardGadgetService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboardService, err := internal.NewDashboardService(client, APIVersion, dashboardGadgetService)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +157,12 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	priority, err := internal.NewPriorityService(client, APIVersion)
+	priorityScheme, err := internal.NewPrioritySchemeService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := internal.NewPriorityService(client, APIVersion, priorityScheme)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +212,11 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	changelog, err := internal.NewChangelogService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	issueServices := &internal.IssueServices{
 		Attachment: issueAttachmentService,
 		CommentADF: commentService,
@@ -217,6 +232,7 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		Watcher:    watcher,
 		WorklogAdf: worklog,
 		Property:   issueProperty,
+		Changelog:  changelog,
 	}
 
 	mySelf, err := internal.NewMySelfService(client, APIVersion)
@@ -341,11 +357,46 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	bulk, err := internal.NewBulkService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	server, err := internal.NewServerService(client, APIVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	timeTracking, err := internal.NewTimeTrackingService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	issueSecurity, err := internal.NewIssueSecuritySchemeService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := internal.NewWebhookService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	expression, err := internal.NewExpressionService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	entityProperty, err := internal.NewEntityPropertyService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	avatar, err := internal.NewAvatarService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	userSearch, err := internal.NewUserSearchService(client, APIVersion)
 	if err != nil {
 		return nil, err
@@ -390,7 +441,14 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 	client.Project = project
 	client.Screen = screen
 	client.Task = task
+	client.Bulk = bulk
 	client.Server = server
+	client.TimeTracking = timeTracking
+	client.IssueSecurity = issueSecurity
+	client.Webhook = webhook
+	client.Expression = expression
+	client.EntityProperty = entityProperty
+	client.Avatar = avatar
 	client.User = user
 	client.Workflow = workflow
 	client.JQL = jql
@@ -419,7 +477,14 @@ type Client struct {
 	Project            *internal.ProjectService
 	Screen             *internal.ScreenService
 	Task               *internal.TaskService
+	Bulk               *internal.BulkService
 	Server             *internal.ServerService
+	TimeTracking       *internal.TimeTrackingService
+	IssueSecurity      *internal.IssueSecuritySchemeService
+	Webhook            *internal.WebhookService
+	Expression         *internal.ExpressionService
+	EntityProperty     *internal.EntityPropertyService
+	Avatar             *internal.AvatarService
 	User               *internal.UserService
 	Workflow           *internal.WorkflowService
 	JQL                *internal.JQLService
@@ -439,20 +504,22 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 
 	u := c.Site.ResolveReference(rel)
 
-	buf := new(bytes.Buffer)
-	if body != nil {
-		if err = json.NewEncoder(buf).Encode(body); err != nil {
-			return nil, err
+	// If the body interface is a reader type (e.g. *bytes.Buffer or *io.PipeReader)
+	// it means the NewRequest() requires to handle the RFC 1867 ISO, the body is sent as it is
+	reader, ok := body.(io.Reader)
+	if !ok {
+
+		buf := new(bytes.Buffer)
+		if body != nil {
+			if err = json.NewEncoder(buf).Encode(body); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	// If the body interface is a *bytes.Buffer type
-	// it means the NewRequest() requires to handle the RFC 1867 ISO
-	if attachBuffer, ok := body.(*bytes.Buffer); ok {
-		buf = attachBuffer
+		reader = buf
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
 	if err != nil {
 		return nil, err
 	}
@@ -494,6 +561,27 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 	return c.processResponse(response, structure)
 }
 
+// Stream sends an API request and returns the response with its body unread, the caller closes it.
+// The unsuccessful responses are read and closed, as on Call.
+func (c *Client) Stream(request *http.Request) (*models.ResponseScheme, error) {
+
+	response, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return c.processResponse(response, nil)
+	}
+
+	return &models.ResponseScheme{
+		Response: response,
+		Code:     response.StatusCode,
+		Endpoint: response.Request.URL.String(),
+		Method:   response.Request.Method,
+	}, nil
+}
+
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
 
 	defer response.Body.Close()
@@ -389,6 +389,72 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequest_Reader(t *testing.T) {
+
+	siteAsURL, err := url.Parse("https://ctreminiom.atlassian.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Client{HTTP: http.DefaultClient, Auth: internal.NewAuthenticationService(nil), Site: siteAsURL}
+
+	reader, writer := io.Pipe()
+	go func() {
+		_, _ = writer.Write([]byte("Hello World"))
+		_ = writer.Close()
+	}()
+
+	request, err := c.NewRequest(context.Background(), http.MethodPost, "rest/3/issue/KP-1/attachments", "multipart/form-data", reader)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello World", string(body))
+	assert.Equal(t, "no-check", request.Header.Get("X-Atlassian-Token"))
+}
+
+func TestClient_Stream(t *testing.T) {
+
+	request := &http.Request{Method: http.MethodGet, URL: &url.URL{}}
+
+	t.Run("when the response is successful", func(t *testing.T) {
+
+		client := mocks.NewHTTPClient(t)
+		client.On("Do", request).
+			Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("Hello, world!")), Request: request}, nil)
+
+		response, err := (&Client{HTTP: client}).Stream(request)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Zero(t, response.Bytes.Len())
+
+		body, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello, world!", string(body))
+	})
+
+	t.Run("when the response is not successful", func(t *testing.T) {
+
+		client := mocks.NewHTTPClient(t)
+		client.On("Do", request).
+			Return(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("Not found")), Request: request}, nil)
+
+		response, err := (&Client{HTTP: client}).Stream(request)
+		assert.ErrorIs(t, err, model.ErrNotFound)
+		assert.Equal(t, "Not found", response.Bytes.String())
+	})
+
+	t.Run("when the request cannot be sent", func(t *testing.T) {
+
+		client := mocks.NewHTTPClient(t)
+		client.On("Do", request).
+			Return(nil, errors.New("error, unable to send the request"))
+
+		_, err := (&Client{HTTP: client}).Stream(request)
+		assert.EqualError(t, err, "error, unable to send the request")
+	})
+}
+
 func TestClient_processResponse(t *testing.T) {
 
 	expectedJSONResponse := `
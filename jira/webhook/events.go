@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The names of the webhook events sent by Jira.
+const (
+	IssueCreated = "jira:issue_created"
+	IssueUpdated = "jira:issue_updated"
+	IssueDeleted = "jira:issue_deleted"
+
+	CommentCreated = "comment_created"
+	CommentUpdated = "comment_updated"
+	CommentDeleted = "comment_deleted"
+
+	WorklogCreated = "worklog_created"
+	WorklogUpdated = "worklog_updated"
+	WorklogDeleted = "worklog_deleted"
+
+	SprintCreated = "sprint_created"
+	SprintUpdated = "sprint_updated"
+	SprintStarted = "sprint_started"
+	SprintClosed  = "sprint_closed"
+	SprintDeleted = "sprint_deleted"
+
+	BoardCreated              = "board_created"
+	BoardUpdated              = "board_updated"
+	BoardDeleted              = "board_deleted"
+	BoardConfigurationChanged = "board_configuration_changed"
+)
+
+// Decode decodes the body of a webhook event into the model of its type:
+//
+//   - *models.WebhookIssueEventScheme for the "jira:issue_*" events.
+//   - *models.WebhookCommentEventScheme for the "comment_*" events.
+//   - *models.WebhookWorklogEventScheme for the "worklog_*" events.
+//   - *models.WebhookSprintEventScheme for the "sprint_*" events.
+//   - *models.WebhookBoardEventScheme for the "board_*" events.
+//   - *models.WebhookEventScheme for the other events.
+func Decode(body []byte) (interface{}, error) {
+
+	envelope := new(model.WebhookEventScheme)
+	if err := json.Unmarshal(body, envelope); err != nil {
+		return nil, err
+	}
+
+	if envelope.WebhookEvent == "" {
+		return nil, model.ErrNoWebhookEvent
+	}
+
+	var event interface{}
+	switch name := envelope.WebhookEvent; {
+	case strings.HasPrefix(name, "jira:issue_"):
+		event = new(model.WebhookIssueEventScheme)
+	case strings.HasPrefix(name, "comment_"):
+		event = new(model.WebhookCommentEventScheme)
+	case strings.HasPrefix(name, "worklog_"):
+		event = new(model.WebhookWorklogEventScheme)
+	case strings.HasPrefix(name, "sprint_"):
+		event = new(model.WebhookSprintEventScheme)
+	case strings.HasPrefix(name, "board_"):
+		event = new(model.WebhookBoardEventScheme)
+	default:
+		return envelope, nil
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SignatureHeader is the header of the HMAC signature of the webhooks registered with a secret.
+const SignatureHeader = "X-Hub-Signature"
+
+// maxBodySize is the maximum size of the body of the webhook events read by the Handler.
+const maxBodySize = 10 << 20
+
+// Handler is a http.Handler decoding the webhook events and dispatching them to the callbacks of their type.
+//
+// The callbacks must be registered before the Handler serves requests. The events without callback are
+// acknowledged and ignored. A callback error responds with a 500 status, so Jira retries the delivery.
+type Handler struct {
+	// Secret, when set, verifies the signature of the events, see VerifySignature.
+	Secret string
+	// OnError, when set, receives the errors of the requests, e.g. an invalid signature or a callback error.
+	OnError func(r *http.Request, err error)
+
+	issue   map[string]func(ctx context.Context, event *model.WebhookIssueEventScheme) error
+	comment map[string]func(ctx context.Context, event *model.WebhookCommentEventScheme) error
+	worklog map[string]func(ctx context.Context, event *model.WebhookWorklogEventScheme) error
+	sprint  map[string]func(ctx context.Context, event *model.WebhookSprintEventScheme) error
+	board   map[string]func(ctx context.Context, event *model.WebhookBoardEventScheme) error
+	other   func(ctx context.Context, event *model.WebhookEventScheme, body []byte) error
+}
+
+// HandleIssue registers the callback of the issue events, e.g. IssueCreated.
+func (h *Handler) HandleIssue(eventName string, callback func(ctx context.Context, event *model.WebhookIssueEventScheme) error) {
+
+	if h.issue == nil {
+		h.issue = make(map[string]func(ctx context.Context, event *model.WebhookIssueEventScheme) error)
+	}
+
+	h.issue[eventName] = callback
+}
+
+// HandleComment registers the callback of the comment events, e.g. CommentCreated.
+func (h *Handler) HandleComment(eventName string, callback func(ctx context.Context, event *model.WebhookCommentEventScheme) error) {
+
+	if h.comment == nil {
+		h.comment = make(map[string]func(ctx context.Context, event *model.WebhookCommentEventScheme) error)
+	}
+
+	h.comment[eventName] = callback
+}
+
+// HandleWorklog registers the callback of the worklog events, e.g. WorklogUpdated.
+func (h *Handler) HandleWorklog(eventName string, callback func(ctx context.Context, event *model.WebhookWorklogEventScheme) error) {
+
+	if h.worklog == nil {
+		h.worklog = make(map[string]func(ctx context.Context, event *model.WebhookWorklogEventScheme) error)
+	}
+
+	h.worklog[eventName] = callback
+}
+
+// HandleSprint registers the callback of the sprint events, e.g. SprintStarted.
+func (h *Handler) HandleSprint(eventName string, callback func(ctx context.Context, event *model.WebhookSprintEventScheme) error) {
+
+	if h.sprint == nil {
+		h.sprint = make(map[string]func(ctx context.Context, event *model.WebhookSprintEventScheme) error)
+	}
+
+	h.sprint[eventName] = callback
+}
+
+// HandleBoard registers the callback of the board events, e.g. BoardCreated.
+func (h *Handler) HandleBoard(eventName string, callback func(ctx context.Context, event *model.WebhookBoardEventScheme) error) {
+
+	if h.board == nil {
+		h.board = make(map[string]func(ctx context.Context, event *model.WebhookBoardEventScheme) error)
+	}
+
+	h.board[eventName] = callback
+}
+
+// HandleOther registers the callback of the events without typed model, e.g. "project_created".
+// The callback receives the raw body of the event.
+func (h *Handler) HandleOther(callback func(ctx context.Context, event *model.WebhookEventScheme, body []byte) error) {
+	h.other = callback
+}
+
+// ServeHTTP verifies, decodes and dispatches a webhook event.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		h.fail(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	if h.Secret != "" {
+
+		if err = VerifySignature(h.Secret, r.Header.Get(SignatureHeader), body); err != nil {
+			h.fail(w, r, err, http.StatusUnauthorized)
+			return
+		}
+	}
+
+	event, err := Decode(body)
+	if err != nil {
+		h.fail(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = h.dispatch(r.Context(), event, body); err != nil {
+		h.fail(w, r, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event interface{}, body []byte) error {
+
+	switch event := event.(type) {
+	case *model.WebhookIssueEventScheme:
+		if callback, ok := h.issue[event.WebhookEvent]; ok {
+			return callback(ctx, event)
+		}
+	case *model.WebhookCommentEventScheme:
+		if callback, ok := h.comment[event.WebhookEvent]; ok {
+			return callback(ctx, event)
+		}
+	case *model.WebhookWorklogEventScheme:
+		if callback, ok := h.worklog[event.WebhookEvent]; ok {
+			return callback(ctx, event)
+		}
+	case *model.WebhookSprintEventScheme:
+		if callback, ok := h.sprint[event.WebhookEvent]; ok {
+			return callback(ctx, event)
+		}
+	case *model.WebhookBoardEventScheme:
+		if callback, ok := h.board[event.WebhookEvent]; ok {
+			return callback(ctx, event)
+		}
+	case *model.WebhookEventScheme:
+		if h.other != nil {
+			return h.other(ctx, event, body)
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, err error, status int) {
+
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
+
+// VerifySignature verifies the HMAC signature of a webhook event, the signature header is formatted
+// as "sha256=<hex digest>" by the system and admin webhooks registered with a secret.
+func VerifySignature(secret, signature string, body []byte) error {
+
+	method, digest, ok := strings.Cut(signature, "=")
+	if !ok || method != "sha256" {
+		return model.ErrInvalidWebhookSignature
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return model.ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return model.ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
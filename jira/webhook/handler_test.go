@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	issueUpdatedMocked = `{"timestamp":1704067200000,"webhookEvent":"jira:issue_updated","issue_event_type_name":"issue_generic",
+		"user":{"accountId":"acc-1","displayName":"Jane"},
+		"issue":{"id":"10001","key":"KP-1","fields":{"summary":"Title","description":"h1. Wiki markup"}},
+		"changelog":{"id":"10124","items":[{"field":"status","fieldtype":"jira","fromString":"To Do","toString":"Done"}]}}`
+
+	sprintStartedMocked = `{"timestamp":1704067200000,"webhookEvent":"sprint_started",
+		"sprint":{"id":5,"state":"active","name":"Sprint 5","startDate":"2024-01-01T10:00:00.000Z","originBoardId":2}}`
+)
+
+func sign(secret, body string) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestDecode(t *testing.T) {
+
+	event, err := Decode([]byte(issueUpdatedMocked))
+	assert.NoError(t, err)
+
+	issue, ok := event.(*model.WebhookIssueEventScheme)
+	assert.True(t, ok)
+	assert.Equal(t, "KP-1", issue.Issue.Key)
+	assert.Equal(t, "h1. Wiki markup", issue.Issue.Fields.Description)
+	assert.Equal(t, "Done", issue.Changelog.Items[0].ToString)
+
+	event, err = Decode([]byte(sprintStartedMocked))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, event.(*model.WebhookSprintEventScheme).Sprint.ID)
+
+	event, err = Decode([]byte(`{"webhookEvent":"project_created","timestamp":1704067200000}`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1704067200), event.(*model.WebhookEventScheme).Time().Unix())
+
+	_, err = Decode([]byte(`{"timestamp":1704067200000}`))
+	assert.ErrorIs(t, err, model.ErrNoWebhookEvent)
+
+	_, err = Decode([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+
+	assert.NoError(t, VerifySignature("secret", sign("secret", issueUpdatedMocked), []byte(issueUpdatedMocked)))
+	assert.ErrorIs(t, VerifySignature("other", sign("secret", issueUpdatedMocked), []byte(issueUpdatedMocked)), model.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifySignature("secret", "", []byte(issueUpdatedMocked)), model.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifySignature("secret", "sha1=00", []byte(issueUpdatedMocked)), model.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, VerifySignature("secret", "sha256=zz", []byte(issueUpdatedMocked)), model.ErrInvalidWebhookSignature)
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	var received []string
+	var failures []error
+
+	handler := &Handler{
+		Secret:  "secret",
+		OnError: func(r *http.Request, err error) { failures = append(failures, err) },
+	}
+
+	handler.HandleIssue(IssueUpdated, func(ctx context.Context, event *model.WebhookIssueEventScheme) error {
+		received = append(received, event.Issue.Key)
+		return nil
+	})
+
+	handler.HandleSprint(SprintStarted, func(ctx context.Context, event *model.WebhookSprintEventScheme) error {
+		return errors.New("error, unable to start the sprint")
+	})
+
+	testCases := []struct {
+		name      string
+		method    string
+		body      string
+		signature string
+		want      int
+	}{
+		{
+			name:      "when the event has a callback",
+			method:    http.MethodPost,
+			body:      issueUpdatedMocked,
+			signature: sign("secret", issueUpdatedMocked),
+			want:      http.StatusNoContent,
+		},
+
+		{
+			name:      "when the event has no callback",
+			method:    http.MethodPost,
+			body:      `{"webhookEvent":"comment_created"}`,
+			signature: sign("secret", `{"webhookEvent":"comment_created"}`),
+			want:      http.StatusNoContent,
+		},
+
+		{
+			name:      "when the callback fails",
+			method:    http.MethodPost,
+			body:      sprintStartedMocked,
+			signature: sign("secret", sprintStartedMocked),
+			want:      http.StatusInternalServerError,
+		},
+
+		{
+			name:      "when the signature is invalid",
+			method:    http.MethodPost,
+			body:      issueUpdatedMocked,
+			signature: sign("other", issueUpdatedMocked),
+			want:      http.StatusUnauthorized,
+		},
+
+		{
+			name:      "when the body is invalid",
+			method:    http.MethodPost,
+			body:      `{`,
+			signature: sign("secret", `{`),
+			want:      http.StatusBadRequest,
+		},
+
+		{
+			name:   "when the method is not allowed",
+			method: http.MethodGet,
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(testCase.method, "/webhook-received", strings.NewReader(testCase.body))
+			request.Header.Set(SignatureHeader, testCase.signature)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.want, recorder.Code)
+		})
+	}
+
+	assert.Equal(t, []string{"KP-1"}, received)
+	assert.Len(t, failures, 3)
+}
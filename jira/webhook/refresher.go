@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	// DefaultInterval is the interval between the refreshes of a Refresher.
+	DefaultInterval = 24 * time.Hour
+	// DefaultThreshold is the remaining lifetime under which a webhook is refreshed.
+	DefaultThreshold = 7 * 24 * time.Hour
+)
+
+// Refresher refreshes the dynamic webhooks before their expiration.
+type Refresher struct {
+	// Service lists and refreshes the webhooks.
+	Service Service
+	// IDs are the IDs of the webhooks to keep alive, all the webhooks registered by the app when empty.
+	IDs []int
+	// Interval is the interval between the refreshes of Run, DefaultInterval when zero.
+	Interval time.Duration
+	// Threshold refreshes the webhooks expiring within the duration, DefaultThreshold when zero.
+	Threshold time.Duration
+	// OnRefresh, when set, receives the report of each refresh of Run.
+	OnRefresh func(report *RefreshReport)
+	// OnError, when set, receives the errors of the refreshes of Run.
+	OnError func(err error)
+}
+
+// RefreshReport represents the result of a refresh of the dynamic webhooks.
+type RefreshReport struct {
+	Refreshed   []int             // The IDs of the refreshed webhooks.
+	Missing     []int             // The IDs of the webhooks not registered anymore, e.g. expired or deleted.
+	Expirations map[int]time.Time // The expiration of each registered webhook, after the refresh.
+}
+
+// NextExpiration returns the earliest expiration of the registered webhooks, zero when there are none.
+func (r *RefreshReport) NextExpiration() time.Time {
+
+	var next time.Time
+	for _, expiration := range r.Expirations {
+
+		if next.IsZero() || expiration.Before(next) {
+			next = expiration
+		}
+	}
+
+	return next
+}
+
+// RefreshOnce lists the webhooks and refreshes the ones expiring within the threshold.
+func (r *Refresher) RefreshOnce(ctx context.Context) (*RefreshReport, error) {
+
+	if r.Service == nil {
+		return nil, model.ErrNoWebhookService
+	}
+
+	threshold := r.Threshold
+	if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+
+	webhooks, err := r.webhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RefreshReport{Expirations: make(map[int]time.Time)}
+
+	selected := r.IDs
+	if len(selected) == 0 {
+		for webhookID := range webhooks {
+			selected = append(selected, webhookID)
+		}
+
+		sort.Ints(selected)
+	}
+
+	var expiring []int
+	deadline := time.Now().Add(threshold)
+
+	for _, webhookID := range selected {
+
+		webhook, ok := webhooks[webhookID]
+		if !ok {
+			report.Missing = append(report.Missing, webhookID)
+			continue
+		}
+
+		report.Expirations[webhookID] = webhook.Expiration()
+
+		if webhook.Expiration().Before(deadline) {
+			expiring = append(expiring, webhookID)
+		}
+	}
+
+	for start := 0; start < len(expiring); start += 100 {
+
+		end := start + 100
+		if end > len(expiring) {
+			end = len(expiring)
+		}
+
+		expiration, _, err := r.Service.Refresh(ctx, expiring[start:end])
+		if err != nil {
+			return report, err
+		}
+
+		for _, webhookID := range expiring[start:end] {
+			report.Expirations[webhookID] = expiration.Expiration()
+		}
+
+		report.Refreshed = append(report.Refreshed, expiring[start:end]...)
+	}
+
+	return report, nil
+}
+
+// Run refreshes the webhooks immediately, then at each interval until the context is done.
+// The reports and errors of the refreshes are passed to OnRefresh and OnError.
+func (r *Refresher) Run(ctx context.Context) error {
+
+	if r.Service == nil {
+		return model.ErrNoWebhookService
+	}
+
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		report, err := r.RefreshOnce(ctx)
+		if err != nil && r.OnError != nil {
+			r.OnError(err)
+		}
+
+		if err == nil && r.OnRefresh != nil {
+			r.OnRefresh(report)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// webhooks returns the webhooks registered by the app, keyed by ID.
+func (r *Refresher) webhooks(ctx context.Context) (map[int]*model.WebhookScheme, error) {
+
+	webhooks := make(map[int]*model.WebhookScheme)
+	for startAt := 0; ; {
+
+		page, _, err := r.Service.Gets(ctx, startAt, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, webhook := range page.Values {
+			webhooks[webhook.ID] = webhook
+		}
+
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 {
+			return webhooks, nil
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type serviceMocked struct {
+	pages     []*model.WebhookPageScheme
+	refreshed [][]int
+	err       error
+}
+
+func (s *serviceMocked) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+
+	for _, page := range s.pages {
+		if page.StartAt == startAt {
+			return page, nil, nil
+		}
+	}
+
+	return &model.WebhookPageScheme{IsLast: true}, nil, nil
+}
+
+func (s *serviceMocked) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error) {
+
+	s.refreshed = append(s.refreshed, webhookIDs)
+	return &model.WebhookExpirationScheme{ExpirationDate: time.Now().Add(30 * 24 * time.Hour).UnixMilli()}, nil, nil
+}
+
+func expiresIn(duration time.Duration) int64 {
+	return time.Now().Add(duration).UnixMilli()
+}
+
+func TestRefresher_RefreshOnce(t *testing.T) {
+
+	service := &serviceMocked{
+		pages: []*model.WebhookPageScheme{
+			{StartAt: 0, Values: []*model.WebhookScheme{
+				{ID: 1, ExpirationDate: expiresIn(24 * time.Hour)},
+				{ID: 2, ExpirationDate: expiresIn(20 * 24 * time.Hour)},
+			}},
+			{StartAt: 2, IsLast: true, Values: []*model.WebhookScheme{
+				{ID: 3, ExpirationDate: expiresIn(2 * 24 * time.Hour)},
+			}},
+		},
+	}
+
+	report, err := (&Refresher{Service: service}).RefreshOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 3}}, service.refreshed)
+	assert.Equal(t, []int{1, 3}, report.Refreshed)
+	assert.Empty(t, report.Missing)
+	assert.Len(t, report.Expirations, 3)
+	assert.True(t, report.NextExpiration().After(time.Now().Add(19*24*time.Hour)))
+
+	service.refreshed = nil
+	report, err = (&Refresher{Service: service, IDs: []int{2, 4}, Threshold: 25 * 24 * time.Hour}).RefreshOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{2}}, service.refreshed)
+	assert.Equal(t, []int{4}, report.Missing)
+
+	_, err = (&Refresher{Service: &serviceMocked{err: errors.New("error, request failed. Please fix me")}}).RefreshOnce(context.Background())
+	assert.EqualError(t, err, "error, request failed. Please fix me")
+
+	_, err = (&Refresher{}).RefreshOnce(context.Background())
+	assert.ErrorIs(t, err, model.ErrNoWebhookService)
+}
+
+func TestRefresher_Run(t *testing.T) {
+
+	service := &serviceMocked{
+		pages: []*model.WebhookPageScheme{
+			{IsLast: true, Values: []*model.WebhookScheme{{ID: 1, ExpirationDate: expiresIn(time.Hour)}}},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var reports []*RefreshReport
+	refresher := &Refresher{
+		Service:  service,
+		Interval: time.Millisecond,
+		OnRefresh: func(report *RefreshReport) {
+
+			reports = append(reports, report)
+			if len(reports) == 2 {
+				cancel()
+			}
+		},
+	}
+
+	assert.ErrorIs(t, refresher.Run(ctx), context.Canceled)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, []int{1}, reports[0].Refreshed)
+
+	assert.ErrorIs(t, (&Refresher{}).Run(context.Background()), model.ErrNoWebhookService)
+}
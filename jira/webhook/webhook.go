@@ -0,0 +1,36 @@
+// Package webhook keeps the dynamic webhooks registered by a Jira app alive and decodes the webhook events sent by Jira.
+//
+// The dynamic webhooks expire 30 days after their registration or last refresh, the Refresher
+// lists the webhooks periodically and refreshes the ones close to their expiration:
+//
+//	refresher := &webhook.Refresher{
+//		Service:   client.Webhook,
+//		OnRefresh: func(report *webhook.RefreshReport) { log.Println(report.Refreshed, report.Missing) },
+//		OnError:   func(err error) { log.Println(err) },
+//	}
+//
+//	go refresher.Run(ctx)
+//
+// The Handler decodes the events received by the app and dispatches them to the callbacks of their type,
+// verifying their signature when the webhook is registered with a secret:
+//
+//	handler := &webhook.Handler{Secret: os.Getenv("JIRA_WEBHOOK_SECRET")}
+//	handler.HandleIssue(webhook.IssueUpdated, func(ctx context.Context, event *models.WebhookIssueEventScheme) error {
+//		log.Println(event.Issue.Key, event.Changelog)
+//		return nil
+//	})
+//
+//	http.Handle("/webhook-received", handler)
+package webhook
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Service lists and refreshes the dynamic webhooks, e.g. client.Webhook.
+type Service interface {
+	Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error)
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error)
+}
@@ -159,6 +159,12 @@ var (
 	ErrNoValueType                    = errors.New("custom-field: no value set")
 	ErrNoRequestType                  = errors.New("custom-field: no request type value set")
 	ErrNoTempoAccountType             = errors.New("custom-field: no tempo account value set")
+	ErrNoVersionType                  = errors.New("custom-field: no version type set")
+	ErrNoProjectType                  = errors.New("custom-field: no project type set")
+	ErrNoADFType                      = errors.New("custom-field: no adf type set")
+	ErrNoTeamType                     = errors.New("custom-field: no team type set")
+	ErrNoParentLinkType               = errors.New("custom-field: no parent link type set")
+	ErrCustomFieldValueNotAllowed     = errors.New("custom-field: value not allowed by the field metadata")
 	ErrNoComponents                   = errors.New("sm: no components set")
 	ErrNoIssuesSlice                  = errors.New("jira: no issues object set")
 	ErrNoMapValues                    = errors.New("jira: no map values set")
@@ -172,6 +178,48 @@ var (
 	ErrNoObjectTypeAttributeID        = errors.New("assets: no object type attribute id set")
 	ErrNoCreateIssues                 = errors.New("jira: no issues payload set")
 	ErrNoIssueScheme                  = errors.New("jira: no issue instance set")
+	ErrNoIssueFieldsStruct            = errors.New("jira: no struct pointer set")
+	ErrNoFieldNameMapping             = errors.New("jira: no field id found for the field name")
+	ErrNoParsedQuery                  = errors.New("jira: no parsed query set")
+	ErrInvalidParsedQuery             = errors.New("jira: the parsed query contains errors")
+	ErrNoJQLOperands                  = errors.New("jira: no jql operands set")
+	ErrNoChangelogIDs                 = errors.New("jira: no changelog id's set")
+	ErrNoIssueKeysOrIDs               = errors.New("jira: no issue keys/id's set")
+	ErrIssueNotCreated                = errors.New("jira: the issue was not created at the timestamp")
+	ErrNoStatusNameOrID               = errors.New("jira: no status name or id set")
+	ErrNoTransitionPath               = errors.New("jira: no workflow path found to the status")
+	ErrNoBatchOperation               = errors.New("jira: no batch operation set")
+	ErrNoCloneIssueType               = errors.New("jira: no issue type found on the target project")
+	ErrNoIssueCloneServices           = errors.New("jira: no issue services set to clone the issue")
+	ErrNoSearchFunc                   = errors.New("jira: no search function set")
+	ErrNoCreatesFunc                  = errors.New("jira: no creates function set")
+	ErrNoCSVHeader                    = errors.New("jira: no csv header row found")
+	ErrNoMetadataService              = errors.New("jira: no metadata service set")
+	ErrNoProjectOrIssueType           = errors.New("jira: the project and the issue type are required to create an issue")
+	ErrInvalidDuration                = errors.New("jira: invalid duration")
+	ErrNoWorklogsFunc                 = errors.New("jira: no worklogs function set")
+	ErrNoWorklogChanges               = errors.New("jira: no worklog change fetcher set")
+	ErrNoIssueSecuritySchemeID        = errors.New("jira: no issue security scheme id set")
+	ErrNoIssueSecurityLevelID         = errors.New("jira: no issue security level id set")
+	ErrNoIssueSecurityMemberID        = errors.New("jira: no issue security level member id set")
+	ErrNoWebhookIDs                   = errors.New("jira: no webhook ids set")
+	ErrNoWebhookService               = errors.New("jira: no webhook service set")
+	ErrNoWebhookEvent                 = errors.New("jira: no webhook event set")
+	ErrInvalidWebhookSignature        = errors.New("jira: invalid webhook signature")
+	ErrNoExpression                   = errors.New("jira: no expression set")
+	ErrNoPropertyEntity               = errors.New("jira: no property entity set")
+	ErrInvalidPropertyEntity          = errors.New("jira: invalid property entity kind")
+	ErrNoAvatarType                   = errors.New("jira: no avatar type set")
+	ErrNoAvatarOwner                  = errors.New("jira: no avatar owner set")
+	ErrNoAvatarID                     = errors.New("jira: no avatar id set")
+	ErrInvalidAvatarType              = errors.New("jira: invalid avatar type")
+	ErrNoStatusID                     = errors.New("jira: no status id set")
+	ErrNoStatusCategoryIDOrKey        = errors.New("jira: no status category id or key set")
+	ErrNoGadgetID                     = errors.New("jira: no gadget id set")
+	ErrNoShareScope                   = errors.New("jira: no share scope set")
+	ErrInvalidShareScope              = errors.New("jira: invalid share scope, use GLOBAL, AUTHENTICATED or PRIVATE")
+	ErrNoFilterColumns                = errors.New("jira: no filter columns set")
+	ErrNoPrioritySchemeID             = errors.New("jira: no priority scheme id set")
 	ErrNoWorkspace                    = errors.New("bitbucket: no workspace set")
 	ErrNoMemberID                     = errors.New("bitbucket: no member id set")
 	ErrNoWebhookID                    = errors.New("bitbucket: no webhook id set")
@@ -7,3 +7,34 @@ type AvatarURLScheme struct {
 	One6X16   string `json:"16x16,omitempty"` // The URL for the 16x16 size of the avatar.
 	Three2X32 string `json:"32x32,omitempty"` // The URL for the 32x32 size of the avatar.
 }
+
+// The types of the entities owning avatars in Jira.
+const (
+	AvatarTypeProject   = "project"   // The avatars of the projects.
+	AvatarTypeIssueType = "issuetype" // The avatars of the issue types.
+	AvatarTypePriority  = "priority"  // The avatars of the priorities.
+)
+
+// AvatarsScheme represents the system and custom avatars of an entity in Jira.
+type AvatarsScheme struct {
+	System []*AvatarScheme `json:"system,omitempty"` // The system avatars.
+	Custom []*AvatarScheme `json:"custom,omitempty"` // The custom avatars uploaded for the entity.
+}
+
+// AvatarScheme represents an avatar in Jira.
+type AvatarScheme struct {
+	ID             string           `json:"id,omitempty"`             // The ID of the avatar.
+	Owner          string           `json:"owner,omitempty"`          // The ID of the entity owning the avatar, empty for the system avatars.
+	IsSystemAvatar bool             `json:"isSystemAvatar,omitempty"` // Indicates if the avatar is a system avatar.
+	IsSelected     bool             `json:"isSelected,omitempty"`     // Indicates if the avatar is used by the entity.
+	IsDeletable    bool             `json:"isDeletable,omitempty"`    // Indicates if the avatar can be deleted.
+	FileName       string           `json:"fileName,omitempty"`       // The file name of the avatar, returned for the system avatars.
+	URLs           *AvatarURLScheme `json:"urls,omitempty"`           // The URLs of the avatar, per size.
+}
+
+// AvatarCropScheme represents the square area of an uploaded image used as avatar, in pixels.
+type AvatarCropScheme struct {
+	X    int // The X coordinate of the top-left corner of the area.
+	Y    int // The Y coordinate of the top-left corner of the area.
+	Size int // The length of the sides of the area, the image is used as it is when zero.
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+)
+
+// The statuses of a bulk operation task in Jira.
+const (
+	BulkTaskStatusEnqueued        = "ENQUEUED"
+	BulkTaskStatusRunning         = "RUNNING"
+	BulkTaskStatusComplete        = "COMPLETE"
+	BulkTaskStatusFailed          = "FAILED"
+	BulkTaskStatusCancelRequested = "CANCEL_REQUESTED"
+	BulkTaskStatusCancelled       = "CANCELLED"
+	BulkTaskStatusDead            = "DEAD"
+)
+
+// IsFinalTaskStatus reports whether a task status is final, the task is complete, failed, cancelled or dead.
+func IsFinalTaskStatus(status string) bool {
+
+	switch status {
+	case BulkTaskStatusComplete, BulkTaskStatusFailed, BulkTaskStatusCancelled, BulkTaskStatusDead:
+		return true
+	}
+
+	return false
+}
+
+// BulkEditableFieldPageScheme represents a page of the fields that can be edited in bulk in Jira.
+type BulkEditableFieldPageScheme struct {
+	EndingBefore  string                     `json:"endingBefore,omitempty"`  // The end cursor, used to fetch the previous page.
+	StartingAfter string                     `json:"startingAfter,omitempty"` // The start cursor, used to fetch the next page.
+	Fields        []*BulkEditableFieldScheme `json:"fields,omitempty"`        // The fields that can be edited.
+}
+
+// BulkEditableFieldScheme represents a field that can be edited in bulk in Jira.
+type BulkEditableFieldScheme struct {
+	ID                      string        `json:"id,omitempty"`                      // The ID of the field.
+	Name                    string        `json:"name,omitempty"`                    // The name of the field.
+	Type                    string        `json:"type,omitempty"`                    // The type of the field.
+	Description             string        `json:"description,omitempty"`             // The description of the field.
+	IsRequired              bool          `json:"isRequired,omitempty"`              // Indicates if the field is required.
+	SearchURL               string        `json:"searchUrl,omitempty"`               // The URL used to search the field values.
+	UnavailableMessage      string        `json:"unavailableMessage,omitempty"`      // The reason the field cannot be edited.
+	MultiSelectFieldOptions []string      `json:"multiSelectFieldOptions,omitempty"` // The edit options of the multi-select fields, e.g. ADD, REMOVE, REPLACE, REMOVE_ALL.
+	FieldOptions            []interface{} `json:"fieldOptions,omitempty"`            // The values allowed on the field.
+}
+
+// BulkEditPayloadScheme represents the payload used to edit issues in bulk in Jira.
+type BulkEditPayloadScheme struct {
+	SelectedIssueIDsOrKeys []string               `json:"selectedIssueIdsOrKeys,omitempty"` // The IDs or keys of the issues, up to 1000.
+	SelectedActions        []string               `json:"selectedActions,omitempty"`        // The IDs of the fields to edit.
+	EditedFieldsInput      map[string]interface{} `json:"editedFieldsInput,omitempty"`      // The new values of the fields, e.g. "labelsFields" or "priority".
+	SendBulkNotification   *bool                  `json:"sendBulkNotification,omitempty"`   // Indicates if a bulk change notification email is sent.
+}
+
+// BulkMovePayloadScheme represents the payload used to move issues in bulk in Jira.
+type BulkMovePayloadScheme struct {
+	SendBulkNotification *bool `json:"sendBulkNotification,omitempty"` // Indicates if a bulk change notification email is sent.
+	// TargetToSourcesMapping maps the targets to the issues moved, the keys use the
+	// "<project key or ID>,<issue type ID>[,<parent ID or key>]" format.
+	TargetToSourcesMapping map[string]*BulkMoveTargetScheme `json:"targetToSourcesMapping,omitempty"`
+}
+
+// BulkMoveTargetScheme represents the issues moved to a project and issue type in Jira.
+type BulkMoveTargetScheme struct {
+	InferClassificationDefaults bool                     `json:"inferClassificationDefaults"`     // Indicates if the classification is inferred.
+	InferFieldDefaults          bool                     `json:"inferFieldDefaults"`              // Indicates if the field values are inferred.
+	InferStatusDefaults         bool                     `json:"inferStatusDefaults"`             // Indicates if the statuses are inferred.
+	InferSubtaskTypeDefault     bool                     `json:"inferSubtaskTypeDefault"`         // Indicates if the subtask type is inferred.
+	IssueIDsOrKeys              []string                 `json:"issueIdsOrKeys,omitempty"`        // The IDs or keys of the issues moved.
+	TargetClassification        []map[string]interface{} `json:"targetClassification,omitempty"`  // The classification mappings.
+	TargetMandatoryFields       []map[string]interface{} `json:"targetMandatoryFields,omitempty"` // The values of the mandatory fields on the target.
+	TargetStatus                []map[string]interface{} `json:"targetStatus,omitempty"`          // The status mappings.
+}
+
+// BulkTransitionPageScheme represents a page of the transitions available for issues in bulk in Jira.
+type BulkTransitionPageScheme struct {
+	AvailableTransitions []*BulkIssueTransitionsScheme `json:"availableTransitions,omitempty"` // The transitions, grouped by workflow.
+	EndingBefore         string                        `json:"endingBefore,omitempty"`         // The end cursor, used to fetch the previous page.
+	StartingAfter        string                        `json:"startingAfter,omitempty"`        // The start cursor, used to fetch the next page.
+}
+
+// BulkIssueTransitionsScheme represents the transitions available for a group of issues sharing a workflow in Jira.
+type BulkIssueTransitionsScheme struct {
+	IsTransitionsFiltered bool                    `json:"isTransitionsFiltered,omitempty"` // Indicates if some transitions were filtered.
+	Issues                []string                `json:"issues,omitempty"`                // The keys of the issues.
+	Transitions           []*BulkTransitionScheme `json:"transitions,omitempty"`           // The transitions available.
+}
+
+// BulkTransitionScheme represents a transition available for issues in bulk in Jira.
+type BulkTransitionScheme struct {
+	IsTransitionAvailableForAllIssues bool                        `json:"isTransitionAvailableForAllIssues,omitempty"` // Indicates if all the issues can use the transition.
+	To                                *BulkTransitionStatusScheme `json:"to,omitempty"`                                // The status the issues transition to.
+	TransitionID                      int                         `json:"transitionId,omitempty"`                      // The ID of the transition.
+	TransitionName                    string                      `json:"transitionName,omitempty"`                    // The name of the transition.
+}
+
+// BulkTransitionStatusScheme represents the target status of a bulk transition in Jira.
+type BulkTransitionStatusScheme struct {
+	StatusCategory string `json:"statusCategory,omitempty"` // The category of the status, e.g. TODO, IN_PROGRESS or DONE.
+	StatusID       int    `json:"statusId,omitempty"`       // The ID of the status.
+	StatusName     string `json:"statusName,omitempty"`     // The name of the status.
+}
+
+// BulkTransitionPayloadScheme represents the payload used to transition issues in bulk in Jira.
+type BulkTransitionPayloadScheme struct {
+	BulkTransitionInputs []*BulkTransitionInputScheme `json:"bulkTransitionInputs,omitempty"` // The issues and the transition used on them.
+	SendBulkNotification *bool                        `json:"sendBulkNotification,omitempty"` // Indicates if a bulk change notification email is sent.
+}
+
+// BulkTransitionInputScheme represents the issues transitioned using the same transition in Jira.
+type BulkTransitionInputScheme struct {
+	SelectedIssueIDsOrKeys []string `json:"selectedIssueIdsOrKeys,omitempty"` // The IDs or keys of the issues.
+	TransitionID           string   `json:"transitionId,omitempty"`           // The ID of the transition.
+}
+
+// BulkDeletePayloadScheme represents the payload used to delete issues in bulk in Jira.
+type BulkDeletePayloadScheme struct {
+	SelectedIssueIDsOrKeys []string `json:"selectedIssueIdsOrKeys,omitempty"` // The IDs or keys of the issues, up to 1000.
+	SendBulkNotification   *bool    `json:"sendBulkNotification,omitempty"`   // Indicates if a bulk change notification email is sent.
+}
+
+// BulkTaskScheme represents the task created by a bulk operation in Jira.
+type BulkTaskScheme struct {
+	TaskID string `json:"taskId,omitempty"` // The ID of the task, used to track the progress of the operation.
+}
+
+// BulkOperationProgressScheme represents the progress of a bulk operation in Jira.
+type BulkOperationProgressScheme struct {
+	TaskID                          string              `json:"taskId,omitempty"`                          // The ID of the task.
+	Status                          string              `json:"status,omitempty"`                          // The status of the task, e.g. RUNNING or COMPLETE.
+	ProgressPercent                 int                 `json:"progressPercent,omitempty"`                 // The progress of the task.
+	TotalIssueCount                 int                 `json:"totalIssueCount,omitempty"`                 // The number of issues of the operation.
+	InvalidOrInaccessibleIssueCount int                 `json:"invalidOrInaccessibleIssueCount,omitempty"` // The number of issues that couldn't be accessed.
+	ProcessedAccessibleIssues       []int               `json:"processedAccessibleIssues,omitempty"`       // The IDs of the issues processed successfully.
+	FailedAccessibleIssues          map[string][]string `json:"failedAccessibleIssues,omitempty"`          // The errors of the failed issues, indexed by issue ID.
+	Created                         string              `json:"created,omitempty"`                         // The creation time of the task.
+	Started                         string              `json:"started,omitempty"`                         // The start time of the task.
+	Updated                         string              `json:"updated,omitempty"`                         // The last update time of the task.
+	SubmittedBy                     *UserScheme         `json:"submittedBy,omitempty"`                     // The user who submitted the task.
+}
+
+// BulkIssueResultScheme represents the result of a bulk operation on an issue.
+type BulkIssueResultScheme struct {
+	IssueID string   // The ID of the issue.
+	Success bool     // Indicates if the issue was processed successfully.
+	Errors  []string // The errors of the failed issue.
+}
+
+// IsFinished reports whether the task reached a final status.
+func (p *BulkOperationProgressScheme) IsFinished() bool {
+	return IsFinalTaskStatus(p.Status)
+}
+
+// Results returns the per-issue results, the successful issues first and the failed issues sorted by ID.
+func (p *BulkOperationProgressScheme) Results() []*BulkIssueResultScheme {
+
+	results := make([]*BulkIssueResultScheme, 0, len(p.ProcessedAccessibleIssues)+len(p.FailedAccessibleIssues))
+
+	for _, issueID := range p.ProcessedAccessibleIssues {
+		results = append(results, &BulkIssueResultScheme{IssueID: strconv.Itoa(issueID), Success: true})
+	}
+
+	failedIDs := make([]string, 0, len(p.FailedAccessibleIssues))
+	for issueID := range p.FailedAccessibleIssues {
+		failedIDs = append(failedIDs, issueID)
+	}
+
+	sort.Strings(failedIDs)
+
+	for _, issueID := range failedIDs {
+		results = append(results, &BulkIssueResultScheme{IssueID: issueID, Errors: p.FailedAccessibleIssues[issueID]})
+	}
+
+	return results
+}
@@ -38,3 +38,34 @@ type IssueChangelogHistoryItemScheme struct {
 	To         string `json:"to,omitempty"`         // The new value of the field.
 	ToString   string `json:"toString,omitempty"`   // The new value of the field as a string.
 }
+
+// IssueChangelogPageScheme represents a page of the changelog of an issue in Jira.
+type IssueChangelogPageScheme struct {
+	Self       string                         `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                         `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                            `json:"maxResults,omitempty"` // The maximum number of histories returned.
+	StartAt    int                            `json:"startAt,omitempty"`    // The index of the first history returned.
+	Total      int                            `json:"total,omitempty"`      // The total number of histories.
+	IsLast     bool                           `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`     // The histories of the page.
+}
+
+// IssueChangelogBulkFetchPayloadScheme represents the payload used to fetch the changelogs of several issues in Jira.
+type IssueChangelogBulkFetchPayloadScheme struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"` // The IDs or keys of the issues, up to 1000.
+	FieldIDs       []string `json:"fieldIds,omitempty"`       // The IDs of the fields used to filter the changelogs, up to 10.
+	MaxResults     int      `json:"maxResults,omitempty"`     // The maximum number of histories returned per page.
+	NextPageToken  string   `json:"nextPageToken,omitempty"`  // The token of the page to fetch, empty to fetch the first page.
+}
+
+// IssueChangelogBulkFetchScheme represents a page of changelogs returned by the bulk fetch in Jira.
+type IssueChangelogBulkFetchScheme struct {
+	IssueChangeLogs []*IssueChangelogBulkFetchItemScheme `json:"issueChangeLogs,omitempty"` // The changelogs of the issues.
+	NextPageToken   string                               `json:"nextPageToken,omitempty"`   // The token of the next page, empty on the last page.
+}
+
+// IssueChangelogBulkFetchItemScheme represents the changelog of an issue returned by the bulk fetch in Jira.
+type IssueChangelogBulkFetchItemScheme struct {
+	IssueID         string                         `json:"issueId,omitempty"`         // The ID of the issue.
+	ChangeHistories []*IssueChangelogHistoryScheme `json:"changeHistories,omitempty"` // The histories of the issue.
+}
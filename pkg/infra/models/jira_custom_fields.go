@@ -2,7 +2,10 @@
 package models
 
 import (
+	"fmt"
 	"time"
+
+	"github.com/tidwall/gjson"
 )
 
 // CustomFields represents a collection of custom fields.
@@ -377,3 +380,387 @@ func (c *CustomFields) Raw(customFieldID string, value interface{}) error {
 	c.Fields = append(c.Fields, fieldsNode)
 	return nil
 }
+
+// Labels adds a labels custom field to the collection.
+func (c *CustomFields) Labels(customFieldID string, labels []string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(labels) == 0 {
+		return ErrNoLabelsType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = labels
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Version adds a single version picker custom field to the collection.
+func (c *CustomFields) Version(customFieldID, versionID string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(versionID) == 0 {
+		return ErrNoVersionType
+	}
+
+	var versionNode = map[string]interface{}{}
+	versionNode["id"] = versionID
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = versionNode
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Versions adds a multi version picker custom field to the collection.
+func (c *CustomFields) Versions(customFieldID string, versionIDs []string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(versionIDs) == 0 {
+		return ErrNoMultiVersionType
+	}
+
+	var versionsNode []map[string]interface{}
+	for _, versionID := range versionIDs {
+
+		var versionNode = map[string]interface{}{}
+		versionNode["id"] = versionID
+
+		versionsNode = append(versionsNode, versionNode)
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = versionsNode
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Project adds a project picker custom field to the collection.
+func (c *CustomFields) Project(customFieldID, projectID string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(projectID) == 0 {
+		return ErrNoProjectType
+	}
+
+	var projectNode = map[string]interface{}{}
+	projectNode["id"] = projectID
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = projectNode
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// ADF adds a rich-text custom field to the collection.
+// The Jira v3 endpoints expect the paragraph (textarea) custom fields in the Atlassian Document Format.
+func (c *CustomFields) ADF(customFieldID string, body *CommentNodeScheme) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if body == nil {
+		return ErrNoADFType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = body
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Assets adds a Jira Assets object custom field to the collection.
+// The objects are referenced using the workspace ID and the object IDs.
+func (c *CustomFields) Assets(customFieldID, workspaceID string, objectIDs []string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(workspaceID) == 0 {
+		return ErrNoWorkspaceID
+	}
+
+	if len(objectIDs) == 0 {
+		return ErrNoAssetType
+	}
+
+	var objectsNode []map[string]interface{}
+	for _, objectID := range objectIDs {
+
+		var objectNode = map[string]interface{}{}
+		objectNode["workspaceId"] = workspaceID
+		objectNode["id"] = fmt.Sprintf("%v:%v", workspaceID, objectID)
+
+		objectsNode = append(objectsNode, objectNode)
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = objectsNode
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Sprint adds a sprint custom field to the collection.
+func (c *CustomFields) Sprint(customFieldID string, sprintID int) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if sprintID <= 0 {
+		return ErrNoSprintType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = sprintID
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Team adds an Atlassian team custom field to the collection.
+func (c *CustomFields) Team(customFieldID, teamID string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(teamID) == 0 {
+		return ErrNoTeamType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = teamID
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// ParentLink adds an Advanced Roadmaps parent link custom field to the collection.
+func (c *CustomFields) ParentLink(customFieldID, issueKey string) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if len(issueKey) == 0 {
+		return ErrNoParentLinkType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = issueKey
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// TempoAccount adds a Tempo account custom field to the collection.
+func (c *CustomFields) TempoAccount(customFieldID string, accountID int) error {
+
+	if len(customFieldID) == 0 {
+		return ErrNoFieldID
+	}
+
+	if accountID <= 0 {
+		return ErrNoTempoAccountType
+	}
+
+	var fieldNode = map[string]interface{}{}
+	fieldNode[customFieldID] = accountID
+
+	var fieldsNode = map[string]interface{}{}
+	fieldsNode["fields"] = fieldNode
+
+	c.Fields = append(c.Fields, fieldsNode)
+	return nil
+}
+
+// Validate checks the values of the collection against the allowed values of the issue metadata.
+//
+// The metadata parameter accepts the responses of the Issue.Metadata methods: Get (edit metadata),
+// Create (create metadata using the "projects.issuetypes.fields" expand) and FetchFieldMappings.
+//
+// Fields missing from the metadata, or without allowed values, are not validated.
+func (c *CustomFields) Validate(metadata gjson.Result) error {
+
+	for _, fieldsNode := range c.Fields {
+
+		fieldNode, ok := fieldsNode["fields"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for customFieldID, value := range fieldNode {
+
+			allowedValues := customFieldMetadata(metadata, customFieldID).Get("allowedValues")
+			if !allowedValues.IsArray() || value == nil {
+				continue
+			}
+
+			if err := validateCustomFieldValue(allowedValues, value); err != nil {
+				return fmt.Errorf("%w: %v", err, customFieldID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// customFieldMetadata returns the metadata of a field from the edit, create or field mappings metadata responses.
+func customFieldMetadata(metadata gjson.Result, customFieldID string) gjson.Result {
+
+	escaped := gjson.Escape(customFieldID)
+
+	// The edit metadata indexes the fields by ID
+	if field := metadata.Get("fields"); field.IsObject() {
+		return field.Get(escaped)
+	}
+
+	// The field mappings metadata returns the fields as a slice
+	for _, path := range []string{"fields", "values"} {
+		if fields := metadata.Get(path); fields.IsArray() {
+			return fields.Get(fmt.Sprintf("#(fieldId==%q)", customFieldID))
+		}
+	}
+
+	// The create metadata nest the fields under the projects and issue types
+	return metadata.Get("projects.0.issuetypes.0.fields." + escaped)
+}
+
+func validateCustomFieldValue(allowedValues gjson.Result, value interface{}) error {
+
+	switch typed := value.(type) {
+	case []map[string]interface{}:
+		for _, node := range typed {
+			if err := validateCustomFieldValue(allowedValues, node); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case []string:
+		for _, node := range typed {
+			if err := validateCustomFieldValue(allowedValues, node); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case []interface{}:
+		for _, node := range typed {
+			if err := validateCustomFieldValue(allowedValues, node); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case map[string]interface{}:
+		allowed, found := allowedCustomFieldValue(allowedValues, typed)
+		if !found {
+			return ErrCustomFieldValueNotAllowed
+		}
+
+		child, ok := typed["child"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		if _, found = allowedCustomFieldValue(allowed.Get("children"), child); !found {
+			return ErrCustomFieldValueNotAllowed
+		}
+
+		return nil
+
+	default:
+		_, found := allowedCustomFieldValue(allowedValues, map[string]interface{}{
+			"id":    typed,
+			"value": typed,
+			"name":  typed,
+			"key":   typed,
+		})
+
+		if !found {
+			return ErrCustomFieldValueNotAllowed
+		}
+
+		return nil
+	}
+}
+
+func allowedCustomFieldValue(allowedValues gjson.Result, node map[string]interface{}) (gjson.Result, bool) {
+
+	var (
+		match gjson.Result
+		found bool
+	)
+
+	allowedValues.ForEach(func(_, allowed gjson.Result) bool {
+
+		for _, key := range []string{"id", "value", "name", "key", "accountId"} {
+
+			value, ok := node[key]
+			if !ok || !allowed.Get(key).Exists() {
+				continue
+			}
+
+			if allowed.Get(key).String() == fmt.Sprint(value) {
+				match, found = allowed, true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return match, found
+}
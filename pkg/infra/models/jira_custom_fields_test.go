@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 )
 
 func TestCustomFields_Cascading(t *testing.T) {
@@ -968,3 +969,860 @@ func TestCustomFields_Users(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomFields_Labels(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		labels        []string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				labels:        []string{"backend", "api"},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				labels:        []string{"backend"},
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the labels are not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				labels:        nil,
+			},
+			wantErr: true,
+			Err:     ErrNoLabelsType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Labels(testCase.args.customFieldID, testCase.args.labels)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Version(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		versionID     string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				versionID:     "10000",
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				versionID:     "10000",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the version is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				versionID:     "",
+			},
+			wantErr: true,
+			Err:     ErrNoVersionType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Version(testCase.args.customFieldID, testCase.args.versionID)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Versions(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		versionIDs    []string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				versionIDs:    []string{"10000", "10001"},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				versionIDs:    []string{"10000"},
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the versions are not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				versionIDs:    nil,
+			},
+			wantErr: true,
+			Err:     ErrNoMultiVersionType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Versions(testCase.args.customFieldID, testCase.args.versionIDs)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Project(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		projectID     string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				projectID:     "10000",
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				projectID:     "10000",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the project is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				projectID:     "",
+			},
+			wantErr: true,
+			Err:     ErrNoProjectType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Project(testCase.args.customFieldID, testCase.args.projectID)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_ADF(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		body          *CommentNodeScheme
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				body:          &CommentNodeScheme{Version: 1, Type: "doc"},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				body:          &CommentNodeScheme{Version: 1, Type: "doc"},
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the body is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				body:          nil,
+			},
+			wantErr: true,
+			Err:     ErrNoADFType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.ADF(testCase.args.customFieldID, testCase.args.body)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Assets(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		workspaceID   string
+		objectIDs     []string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				workspaceID:   "g2778e1d-939d-581d-c8e2-9d5g59de456b",
+				objectIDs:     []string{"1", "2"},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				workspaceID:   "g2778e1d-939d-581d-c8e2-9d5g59de456b",
+				objectIDs:     []string{"1"},
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the workspace is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				workspaceID:   "",
+				objectIDs:     []string{"1"},
+			},
+			wantErr: true,
+			Err:     ErrNoWorkspaceID,
+		},
+
+		{
+			name:   "when the objects are not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				workspaceID:   "g2778e1d-939d-581d-c8e2-9d5g59de456b",
+				objectIDs:     nil,
+			},
+			wantErr: true,
+			Err:     ErrNoAssetType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Assets(testCase.args.customFieldID, testCase.args.workspaceID, testCase.args.objectIDs)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Sprint(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		sprintID      int
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10020",
+				sprintID:      4,
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				sprintID:      4,
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the sprint is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10020",
+				sprintID:      0,
+			},
+			wantErr: true,
+			Err:     ErrNoSprintType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Sprint(testCase.args.customFieldID, testCase.args.sprintID)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Team(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		teamID        string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				teamID:        "36885b3c-1bf0-4f85-a357-c5b858c31de4",
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				teamID:        "36885b3c-1bf0-4f85-a357-c5b858c31de4",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the team is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				teamID:        "",
+			},
+			wantErr: true,
+			Err:     ErrNoTeamType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.Team(testCase.args.customFieldID, testCase.args.teamID)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_ParentLink(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		issueKey      string
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				issueKey:      "KP-1",
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				issueKey:      "KP-1",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the issue key is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10001",
+				issueKey:      "",
+			},
+			wantErr: true,
+			Err:     ErrNoParentLinkType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.ParentLink(testCase.args.customFieldID, testCase.args.issueKey)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_TempoAccount(t *testing.T) {
+	type fields struct {
+		Fields []map[string]interface{}
+	}
+	type args struct {
+		customFieldID string
+		accountID     int
+	}
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10036",
+				accountID:     22,
+			},
+			wantErr: false,
+		},
+
+		{
+			name:   "when the custom-field is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "",
+				accountID:     22,
+			},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+
+		{
+			name:   "when the account is not provided",
+			fields: fields{},
+			args: args{
+				customFieldID: "customfield_10036",
+				accountID:     0,
+			},
+			wantErr: true,
+			Err:     ErrNoTempoAccountType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &CustomFields{
+				Fields: testCase.fields.Fields,
+			}
+
+			err := c.TempoAccount(testCase.args.customFieldID, testCase.args.accountID)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomFields_Validate(t *testing.T) {
+
+	editMetadataMocked := gjson.Parse(`
+{
+  "fields": {
+    "customfield_10001": {
+      "allowedValues": [
+        {"id": "10040", "value": "High"},
+        {"id": "10041", "value": "Low"}
+      ]
+    },
+    "customfield_10002": {
+      "allowedValues": [
+        {"id": "10050", "value": "America", "children": [{"id": "10051", "value": "US"}]}
+      ]
+    },
+    "customfield_10003": {
+      "allowedValues": [
+        {"id": "10000", "name": "v1.0"},
+        {"id": "10001", "name": "v2.0"}
+      ]
+    },
+    "customfield_10004": {"required": false}
+  }
+}`)
+
+	fieldMappingsMocked := gjson.Parse(`
+{
+  "fields": [
+    {"fieldId": "customfield_10001", "allowedValues": [{"id": "10040", "value": "High"}]}
+  ]
+}`)
+
+	createMetadataMocked := gjson.Parse(`
+{
+  "projects": [
+    {
+      "issuetypes": [
+        {"fields": {"customfield_10001": {"allowedValues": [{"id": "10040", "value": "High"}]}}}
+      ]
+    }
+  ]
+}`)
+
+	testCases := []struct {
+		name     string
+		fields   func() *CustomFields
+		metadata gjson.Result
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name: "when the values are allowed by the edit metadata",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Select("customfield_10001", "High")
+				_ = fields.Cascading("customfield_10002", "America", "US")
+				_ = fields.Versions("customfield_10003", []string{"10000", "10001"})
+				_ = fields.Text("customfield_10004", "Not validated")
+				_ = fields.Text("customfield_10005", "Not present")
+				return fields
+			},
+			metadata: editMetadataMocked,
+		},
+
+		{
+			name: "when the values are allowed by the field mappings metadata",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Select("customfield_10001", "High")
+				return fields
+			},
+			metadata: fieldMappingsMocked,
+		},
+
+		{
+			name: "when the values are allowed by the create metadata",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.MultiSelect("customfield_10001", []string{"High"})
+				return fields
+			},
+			metadata: createMetadataMocked,
+		},
+
+		{
+			name: "when the option is not allowed",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Select("customfield_10001", "Medium")
+				return fields
+			},
+			metadata: editMetadataMocked,
+			wantErr:  true,
+			Err:      ErrCustomFieldValueNotAllowed,
+		},
+
+		{
+			name: "when the cascading child is not allowed",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Cascading("customfield_10002", "America", "CA")
+				return fields
+			},
+			metadata: editMetadataMocked,
+			wantErr:  true,
+			Err:      ErrCustomFieldValueNotAllowed,
+		},
+
+		{
+			name: "when the raw values are allowed",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Raw("customfield_10001", []interface{}{map[string]interface{}{"value": "High"}, "10041"})
+				return fields
+			},
+			metadata: editMetadataMocked,
+		},
+
+		{
+			name: "when a raw value is not allowed",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Raw("customfield_10001", []interface{}{map[string]interface{}{"value": "High"}, "Medium"})
+				return fields
+			},
+			metadata: editMetadataMocked,
+			wantErr:  true,
+			Err:      ErrCustomFieldValueNotAllowed,
+		},
+
+		{
+			name: "when the version is not allowed",
+			fields: func() *CustomFields {
+				fields := &CustomFields{}
+				_ = fields.Versions("customfield_10003", []string{"10000", "10009"})
+				return fields
+			},
+			metadata: editMetadataMocked,
+			wantErr:  true,
+			Err:      ErrCustomFieldValueNotAllowed,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := testCase.fields().Validate(testCase.metadata)
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.ErrorIs(t, err, testCase.Err)
+
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
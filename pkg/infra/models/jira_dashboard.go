@@ -58,3 +58,55 @@ type DashboardSearchOptionsScheme struct {
 	OrderBy             string   // The order by criteria of the dashboard.
 	Expand              []string // The fields to be expanded in the dashboard.
 }
+
+// DashboardGadgetPageScheme represents the gadgets of a dashboard in Jira.
+type DashboardGadgetPageScheme struct {
+	Gadgets []*DashboardGadgetScheme `json:"gadgets,omitempty"` // The gadgets of the dashboard.
+}
+
+// DashboardGadgetScheme represents a gadget of a dashboard in Jira.
+type DashboardGadgetScheme struct {
+	ID        int                            `json:"id,omitempty"`        // The ID of the gadget, also used as dashboard item ID.
+	ModuleKey string                         `json:"moduleKey,omitempty"` // The module key of the gadget type.
+	URI       string                         `json:"uri,omitempty"`       // The URI of the gadget type.
+	Color     string                         `json:"color,omitempty"`     // The color of the gadget, e.g. "blue" or "red".
+	Position  *DashboardGadgetPositionScheme `json:"position,omitempty"`  // The position of the gadget.
+	Title     string                         `json:"title,omitempty"`     // The title of the gadget.
+}
+
+// DashboardGadgetPositionScheme represents the position of a gadget on a dashboard in Jira.
+type DashboardGadgetPositionScheme struct {
+	Row    int `json:"row"`    // The row of the gadget, starting at 0.
+	Column int `json:"column"` // The column of the gadget, starting at 0.
+}
+
+// DashboardGadgetPayloadScheme represents the payload used to add or update a gadget of a dashboard in Jira.
+//
+// The module key or URI of the gadget type are only used to add the gadgets.
+type DashboardGadgetPayloadScheme struct {
+	ModuleKey                       string                         `json:"moduleKey,omitempty"`                       // The module key of the gadget type.
+	URI                             string                         `json:"uri,omitempty"`                             // The URI of the gadget type.
+	Color                           string                         `json:"color,omitempty"`                           // The color of the gadget.
+	Position                        *DashboardGadgetPositionScheme `json:"position,omitempty"`                        // The position of the gadget, the first free position when nil.
+	Title                           string                         `json:"title,omitempty"`                           // The title of the gadget.
+	IgnoreURIAndModuleKeyValidation bool                           `json:"ignoreUriAndModuleKeyValidation,omitempty"` // Adds the gadget without validating its module key and URI.
+}
+
+// DashboardGadgetSearchOptionsScheme represents the options used to filter the gadgets of a dashboard in Jira.
+type DashboardGadgetSearchOptionsScheme struct {
+	ModuleKeys []string // The module keys of the gadget types.
+	URIs       []string // The URIs of the gadget types.
+	GadgetIDs  []int    // The IDs of the gadgets.
+}
+
+// AvailableDashboardGadgetPageScheme represents the gadgets that can be added to the dashboards in Jira.
+type AvailableDashboardGadgetPageScheme struct {
+	Gadgets []*AvailableDashboardGadgetScheme `json:"gadgets,omitempty"` // The available gadgets.
+}
+
+// AvailableDashboardGadgetScheme represents a gadget type that can be added to the dashboards in Jira.
+type AvailableDashboardGadgetScheme struct {
+	ModuleKey string `json:"moduleKey,omitempty"` // The module key of the gadget type.
+	URI       string `json:"uri,omitempty"`       // The URI of the gadget type.
+	Title     string `json:"title,omitempty"`     // The title of the gadget type.
+}
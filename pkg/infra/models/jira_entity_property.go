@@ -0,0 +1,59 @@
+package models
+
+import "encoding/json"
+
+// The kinds of the entities storing properties in Jira.
+const (
+	PropertyEntityIssue         = "issue"         // The ID is the issue ID or key.
+	PropertyEntityProject       = "project"       // The ID is the project ID or key.
+	PropertyEntityIssueType     = "issuetype"     // The ID is the issue type ID.
+	PropertyEntityUser          = "user"          // The ID is the account ID of the user.
+	PropertyEntityComment       = "comment"       // The ID is the comment ID.
+	PropertyEntityWorklog       = "worklog"       // The ID is the worklog ID, the parent ID is the issue ID or key.
+	PropertyEntityDashboardItem = "dashboarditem" // The ID is the dashboard item ID, the parent ID is the dashboard ID.
+	PropertyEntityApp           = "app"           // The ID is the key of the Connect app.
+)
+
+// PropertyEntityScheme identifies the entity storing a property in Jira.
+type PropertyEntityScheme struct {
+	Kind     string // The kind of the entity, e.g. PropertyEntityIssueType.
+	ID       string // The ID of the entity.
+	ParentID string // The ID of the parent entity, used by the worklogs and dashboard items.
+}
+
+// EntityPropertyValue decodes the value of an entity property into the type T.
+func EntityPropertyValue[T any](property *EntityPropertyScheme) (T, error) {
+
+	var value T
+	if property == nil || property.Value == nil {
+		return value, nil
+	}
+
+	data, err := json.Marshal(property.Value)
+	if err != nil {
+		return value, err
+	}
+
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+// IssuePropertyBulkSetPayloadScheme represents the payload used to set a property on several issues in Jira.
+type IssuePropertyBulkSetPayloadScheme struct {
+	Value      interface{}                    `json:"value,omitempty"`      // The value of the property, ignored when the expression is set.
+	Expression string                         `json:"expression,omitempty"` // The Jira expression computing the value of the property of each issue.
+	Filter     *IssuePropertyBulkFilterScheme `json:"filter,omitempty"`     // The filter of the issues, all the issues when nil.
+}
+
+// IssuePropertyBulkFilterScheme represents the filter of the issues of a bulk property update in Jira.
+type IssuePropertyBulkFilterScheme struct {
+	EntityIDs    []int       `json:"entityIds,omitempty"`    // The IDs of the issues.
+	CurrentValue interface{} `json:"currentValue,omitempty"` // The current value of the property of the issues.
+	HasProperty  *bool       `json:"hasProperty,omitempty"`  // Whether the issues have the property or not.
+}
+
+// IssuePropertyBulkDeletePayloadScheme represents the payload used to delete a property from several issues in Jira.
+type IssuePropertyBulkDeletePayloadScheme struct {
+	EntityIDs    []int       `json:"entityIds,omitempty"`    // The IDs of the issues.
+	CurrentValue interface{} `json:"currentValue,omitempty"` // The current value of the property of the issues.
+}
@@ -0,0 +1,149 @@
+package models
+
+import "encoding/json"
+
+// ExpressionEvaluationPayloadScheme represents the payload used to evaluate a Jira expression.
+type ExpressionEvaluationPayloadScheme struct {
+	Expression string                   `json:"expression"`        // The Jira expression to evaluate.
+	Context    *ExpressionContextScheme `json:"context,omitempty"` // The context variables of the expression.
+}
+
+// ExpressionContextScheme represents the context in which a Jira expression is evaluated.
+type ExpressionContextScheme struct {
+	Issue           *ExpressionEntityScheme          `json:"issue,omitempty"`           // The issue available as the "issue" variable.
+	Issues          *ExpressionIssuesContextScheme   `json:"issues,omitempty"`          // The issues available as the "issues" variable.
+	Project         *ExpressionEntityScheme          `json:"project,omitempty"`         // The project available as the "project" variable.
+	Sprint          int                              `json:"sprint,omitempty"`          // The ID of the sprint available as the "sprint" variable.
+	Board           int                              `json:"board,omitempty"`           // The ID of the board available as the "board" variable.
+	ServiceDesk     int                              `json:"serviceDesk,omitempty"`     // The ID of the service desk available as the "serviceDesk" variable.
+	CustomerRequest int                              `json:"customerRequest,omitempty"` // The ID of the customer request available as the "customerRequest" variable.
+	Custom          []*ExpressionCustomContextScheme `json:"custom,omitempty"`          // The custom context variables.
+}
+
+// ExpressionEntityScheme represents an issue or project of the context of a Jira expression, identified by ID or key.
+type ExpressionEntityScheme struct {
+	ID  int    `json:"id,omitempty"`  // The ID of the issue or project.
+	Key string `json:"key,omitempty"` // The key of the issue or project.
+}
+
+// ExpressionIssuesContextScheme represents the issues of the context of a Jira expression.
+type ExpressionIssuesContextScheme struct {
+	JQL *ExpressionJQLContextScheme `json:"jql,omitempty"` // The JQL query selecting the issues.
+}
+
+// ExpressionJQLContextScheme represents a page of the issues matching a JQL query in the context of a Jira expression.
+type ExpressionJQLContextScheme struct {
+	Query         string `json:"query,omitempty"`         // The JQL query.
+	MaxResults    int    `json:"maxResults,omitempty"`    // The maximum number of issues of the page.
+	NextPageToken string `json:"nextPageToken,omitempty"` // The token of the page, empty for the first page.
+}
+
+// Next moves the query to the next page of issues, it returns false when the evaluation metadata contains the last page.
+func (c *ExpressionJQLContextScheme) Next(meta *ExpressionEvaluationMetaScheme) bool {
+
+	if meta == nil || meta.Issues == nil || meta.Issues.JQL == nil || meta.Issues.JQL.IsLast() {
+		return false
+	}
+
+	c.NextPageToken = meta.Issues.JQL.NextPageToken
+	return true
+}
+
+// ExpressionCustomContextScheme represents a custom context variable of a Jira expression.
+type ExpressionCustomContextScheme struct {
+	Variable  string      `json:"variable,omitempty"`  // The name of the variable.
+	Type      string      `json:"type,omitempty"`      // The type of the variable, "user", "issue" or "json".
+	AccountID string      `json:"accountId,omitempty"` // The account ID of the user variables.
+	ID        int         `json:"id,omitempty"`        // The ID of the issue variables.
+	Key       string      `json:"key,omitempty"`       // The key of the issue variables.
+	Value     interface{} `json:"value,omitempty"`     // The value of the JSON variables.
+}
+
+// ExpressionEvaluationScheme represents the result of the evaluation of a Jira expression.
+type ExpressionEvaluationScheme struct {
+	Value json.RawMessage                 `json:"value,omitempty"` // The value of the expression, see ExpressionValue.
+	Meta  *ExpressionEvaluationMetaScheme `json:"meta,omitempty"`  // The metadata of the evaluation.
+}
+
+// ExpressionValue decodes the value of an evaluated Jira expression into the type T.
+func ExpressionValue[T any](result *ExpressionEvaluationScheme) (T, error) {
+
+	var value T
+	if result == nil || len(result.Value) == 0 {
+		return value, nil
+	}
+
+	err := json.Unmarshal(result.Value, &value)
+	return value, err
+}
+
+// ExpressionEvaluationMetaScheme represents the metadata of the evaluation of a Jira expression.
+type ExpressionEvaluationMetaScheme struct {
+	Complexity *ExpressionComplexityScheme `json:"complexity,omitempty"` // The complexity of the evaluation, returned when "meta.complexity" is expanded.
+	Issues     *ExpressionIssuesMetaScheme `json:"issues,omitempty"`     // The pagination of the issues of the context.
+}
+
+// ExpressionComplexityScheme represents the complexity of the evaluation of a Jira expression.
+type ExpressionComplexityScheme struct {
+	Steps               *ExpressionComplexityValueScheme `json:"steps,omitempty"`               // The number of steps of the evaluation.
+	ExpensiveOperations *ExpressionComplexityValueScheme `json:"expensiveOperations,omitempty"` // The number of expensive operations, e.g. loading an entity.
+	Beans               *ExpressionComplexityValueScheme `json:"beans,omitempty"`               // The number of Jira objects of the result.
+	PrimitiveValues     *ExpressionComplexityValueScheme `json:"primitiveValues,omitempty"`     // The number of primitive values of the result.
+}
+
+// ExpressionComplexityValueScheme represents a complexity measure of a Jira expression and its limit.
+type ExpressionComplexityValueScheme struct {
+	Value int `json:"value,omitempty"` // The value of the measure.
+	Limit int `json:"limit,omitempty"` // The maximum value allowed.
+}
+
+// ExpressionIssuesMetaScheme represents the pagination of the issues of the context of a Jira expression.
+type ExpressionIssuesMetaScheme struct {
+	JQL *ExpressionJQLMetaScheme `json:"jql,omitempty"` // The pagination of the issues matching the JQL query.
+}
+
+// ExpressionJQLMetaScheme represents the pagination of the issues matching the JQL query of a Jira expression.
+type ExpressionJQLMetaScheme struct {
+	NextPageToken string `json:"nextPageToken,omitempty"` // The token of the next page, empty on the last page.
+	IsLastPage    bool   `json:"isLast,omitempty"`        // Indicates if the page contains the last issues.
+}
+
+// IsLast indicates if the page contains the last issues matching the JQL query.
+func (m *ExpressionJQLMetaScheme) IsLast() bool {
+	return m.IsLastPage || m.NextPageToken == ""
+}
+
+// ExpressionAnalysisPayloadScheme represents the payload used to analyse Jira expressions.
+type ExpressionAnalysisPayloadScheme struct {
+	Expressions      []string          `json:"expressions"`                // The Jira expressions to analyse.
+	ContextVariables map[string]string `json:"contextVariables,omitempty"` // The types of the custom context variables, keyed by variable name.
+}
+
+// ExpressionAnalysisScheme represents the results of the analysis of Jira expressions.
+type ExpressionAnalysisScheme struct {
+	Results []*ExpressionAnalysisResultScheme `json:"results,omitempty"` // The results, in the order of the payload expressions.
+}
+
+// ExpressionAnalysisResultScheme represents the analysis of a Jira expression.
+type ExpressionAnalysisResultScheme struct {
+	Expression string                              `json:"expression,omitempty"` // The analysed expression.
+	Valid      bool                                `json:"valid,omitempty"`      // Indicates if the expression is valid.
+	Type       string                              `json:"type,omitempty"`       // The type of the expression value, returned by the type check.
+	Errors     []*ExpressionAnalysisErrorScheme    `json:"errors,omitempty"`     // The errors of the expression.
+	Complexity *ExpressionAnalysisComplexityScheme `json:"complexity,omitempty"` // The complexity of the expression, returned by the complexity check.
+}
+
+// ExpressionAnalysisErrorScheme represents an error found by the analysis of a Jira expression.
+type ExpressionAnalysisErrorScheme struct {
+	Line       int    `json:"line,omitempty"`       // The line of the error.
+	Column     int    `json:"column,omitempty"`     // The column of the error.
+	Expression string `json:"expression,omitempty"` // The part of the expression causing the error.
+	Message    string `json:"message,omitempty"`    // The message of the error.
+	Type       string `json:"type,omitempty"`       // The type of the error, "syntax", "type" or "other".
+}
+
+// ExpressionAnalysisComplexityScheme represents the complexity of a Jira expression found by the analysis.
+type ExpressionAnalysisComplexityScheme struct {
+	ExpensiveOperations string            `json:"expensiveOperations,omitempty"` // The formula of the number of expensive operations.
+	Variables           map[string]string `json:"variables,omitempty"`           // The variables of the formula.
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpressionValue(t *testing.T) {
+
+	result := new(ExpressionEvaluationScheme)
+	assert.NoError(t, json.Unmarshal([]byte(`{"value":[{"key":"KP-1","points":3}],"meta":{}}`), result))
+
+	type issue struct {
+		Key    string `json:"key"`
+		Points int    `json:"points"`
+	}
+
+	issues, err := ExpressionValue[[]issue](result)
+	assert.NoError(t, err)
+	assert.Equal(t, []issue{{Key: "KP-1", Points: 3}}, issues)
+
+	_, err = ExpressionValue[string](result)
+	assert.Error(t, err)
+
+	count, err := ExpressionValue[int](nil)
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestExpressionJQLContextScheme_Next(t *testing.T) {
+
+	query := &ExpressionJQLContextScheme{Query: "project = KP", MaxResults: 50}
+
+	meta := &ExpressionEvaluationMetaScheme{Issues: &ExpressionIssuesMetaScheme{JQL: &ExpressionJQLMetaScheme{NextPageToken: "EgQIlMIC"}}}
+	assert.True(t, query.Next(meta))
+	assert.Equal(t, "EgQIlMIC", query.NextPageToken)
+
+	meta.Issues.JQL = &ExpressionJQLMetaScheme{NextPageToken: "EgQIlMID", IsLastPage: true}
+	assert.False(t, query.Next(meta))
+
+	meta.Issues.JQL = &ExpressionJQLMetaScheme{}
+	assert.False(t, query.Next(meta))
+	assert.False(t, query.Next(nil))
+	assert.Equal(t, "EgQIlMIC", query.NextPageToken)
+}
+
+func TestExpressionEvaluationMetaScheme(t *testing.T) {
+
+	result := new(ExpressionEvaluationScheme)
+	assert.NoError(t, json.Unmarshal([]byte(`{"value":[],"meta":{"issues":{"jql":{"nextPageToken":"EgQIlMIC"}}}}`), result))
+	assert.False(t, result.Meta.Issues.JQL.IsLast())
+	assert.Equal(t, "EgQIlMIC", result.Meta.Issues.JQL.NextPageToken)
+}
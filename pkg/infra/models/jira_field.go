@@ -88,3 +88,30 @@ type CustomFieldTempoAccountScheme struct {
 	ID    int    `json:"id"`
 	Value string `json:"value"`
 }
+
+// CustomFieldTeamScheme represents an Atlassian team custom field in Jira.
+type CustomFieldTeamScheme struct {
+	ID         string `json:"id,omitempty"`         // The ID of the team.
+	Name       string `json:"name,omitempty"`       // The name of the team.
+	Title      string `json:"title,omitempty"`      // The title of the team.
+	AvatarURL  string `json:"avatarUrl,omitempty"`  // The avatar URL of the team.
+	IsVisible  bool   `json:"isVisible,omitempty"`  // Indicates if the team is visible.
+	IsVerified bool   `json:"isVerified,omitempty"` // Indicates if the team is verified.
+	IsShared   bool   `json:"isShared,omitempty"`   // Indicates if the team is shared.
+}
+
+// CustomFieldParentLinkScheme represents an Advanced Roadmaps parent link custom field in Jira.
+type CustomFieldParentLinkScheme struct {
+	HasEpicLinkFieldDependency bool                             `json:"hasEpicLinkFieldDependency,omitempty"` // Indicates if the field depends on the epic link field.
+	ShowField                  bool                             `json:"showField,omitempty"`                  // Indicates if the field is shown.
+	Data                       *CustomFieldParentLinkDataScheme `json:"data,omitempty"`                       // The parent issue of the link.
+}
+
+// CustomFieldParentLinkDataScheme represents the parent issue of an Advanced Roadmaps parent link custom field in Jira.
+type CustomFieldParentLinkDataScheme struct {
+	ID        int    `json:"id,omitempty"`        // The ID of the parent issue.
+	IssueKey  string `json:"issueKey,omitempty"`  // The key of the parent issue.
+	KeyNum    int    `json:"keyNum,omitempty"`    // The number of the parent issue key.
+	Summary   string `json:"summary,omitempty"`   // The summary of the parent issue.
+	ProjectID int    `json:"projectId,omitempty"` // The ID of the parent issue project.
+}
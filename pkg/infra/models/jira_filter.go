@@ -97,6 +97,13 @@ type FilterSearchOptionScheme struct {
 	Expand    []string
 }
 
+// The default share scopes of the new filters and dashboards.
+const (
+	ShareScopeGlobal        = "GLOBAL"        // Shared with the public.
+	ShareScopeAuthenticated = "AUTHENTICATED" // Shared with the logged-in users.
+	ShareScopePrivate       = "PRIVATE"       // Not shared.
+)
+
 // ShareFilterScopeScheme represents the scope of a shared filter in Jira.
 type ShareFilterScopeScheme struct {
 	Scope string `json:"scope"`
@@ -109,3 +116,14 @@ type PermissionFilterPayloadScheme struct {
 	GroupName     string `json:"groupname,omitempty"`
 	ProjectRoleID string `json:"projectRoleId,omitempty"`
 }
+
+// FilterColumnScheme represents a column of the issue navigator of a filter in Jira.
+type FilterColumnScheme struct {
+	Label string `json:"label,omitempty"` // The label of the column.
+	Value string `json:"value,omitempty"` // The ID of the field of the column.
+}
+
+// FilterColumnsPayloadScheme represents the payload used to set the columns of a filter in Jira.
+type FilterColumnsPayloadScheme struct {
+	Columns []string `json:"columns"` // The IDs of the fields, in the order of the columns.
+}
@@ -0,0 +1,66 @@
+package models
+
+import "context"
+
+// IssueCloneOptionsScheme represents the options used to clone an issue in Jira.
+type IssueCloneOptionsScheme struct {
+	// ProjectKeyOrID is the project of the clones, the project of the source issue when empty.
+	ProjectKeyOrID string
+	// IssueTypes maps the source issue type IDs or names to the target issue type IDs or names.
+	// The issue types are matched by name on the target project when they're not mapped.
+	IssueTypes map[string]string
+	// SummaryPrefix is prepended to the summary of the clones, e.g. "CLONE - ".
+	SummaryPrefix string
+	// ExcludeFields contains the IDs of the fields not copied.
+	ExcludeFields []string
+	// Children clones the child issues and subtasks, recursively.
+	Children bool
+	// Links copies the issue links, the links between cloned issues are created between the clones.
+	Links bool
+	// LinkType is the name of the issue link type used to link each clone with its source issue, e.g. "Cloners".
+	// The clones aren't linked to the source issues when it's empty.
+	LinkType string
+	// RemoteLinks copies the remote links.
+	RemoteLinks bool
+	// Attachments copies the attachments.
+	Attachments bool
+	// Comments copies the comments, they're added by the calling user.
+	Comments bool
+	// Mapper edits the fields of each clone before it's created, e.g. to map values between projects.
+	// Returning an error stops the clone.
+	Mapper func(ctx context.Context, source *IssueCloneSourceScheme, clone *IssueCloneScheme) error
+	// DryRun describes the issues and the items to be created without creating them.
+	// The clones are referenced by their source issue keys on the parent fields and the links.
+	DryRun bool
+}
+
+// IssueCloneSourceScheme represents the source issue of a clone.
+type IssueCloneSourceScheme struct {
+	ID     string                 `json:"id,omitempty"`     // The ID of the issue.
+	Key    string                 `json:"key,omitempty"`    // The key of the issue.
+	Fields map[string]interface{} `json:"fields,omitempty"` // The fields of the issue, as returned by the API.
+	Names  map[string]string      `json:"names,omitempty"`  // The names of the fields, keyed by field ID.
+}
+
+// IssueCloneScheme represents an issue created, or to be created, by a clone.
+type IssueCloneScheme struct {
+	SourceKey     string                  `json:"sourceKey"`               // The key of the source issue.
+	ID            string                  `json:"id,omitempty"`            // The ID of the clone, empty on dry runs.
+	Key           string                  `json:"key,omitempty"`           // The key of the clone, empty on dry runs.
+	Project       string                  `json:"project,omitempty"`       // The key or ID of the project of the clone.
+	IssueTypeID   string                  `json:"issueTypeId,omitempty"`   // The ID of the issue type of the clone.
+	Fields        map[string]interface{}  `json:"fields,omitempty"`        // The fields sent to create the clone.
+	DroppedFields []string                `json:"droppedFields,omitempty"` // The custom fields not available on the target create screen.
+	Links         []*IssueCloneLinkScheme `json:"links,omitempty"`         // The issue links of the clone.
+	RemoteLinks   []string                `json:"remoteLinks,omitempty"`   // The URLs of the remote links copied.
+	Attachments   []string                `json:"attachments,omitempty"`   // The file names of the attachments copied.
+	Comments      []string                `json:"comments,omitempty"`      // The IDs of the source comments copied.
+	Children      []*IssueCloneScheme     `json:"children,omitempty"`      // The clones of the child issues.
+}
+
+// IssueCloneLinkScheme represents an issue link created, or to be created, by a clone.
+type IssueCloneLinkScheme struct {
+	Type         string `json:"type"`         // The name of the issue link type.
+	InwardIssue  string `json:"inwardIssue"`  // The key of the inward issue.
+	OutwardIssue string `json:"outwardIssue"` // The key of the outward issue.
+}
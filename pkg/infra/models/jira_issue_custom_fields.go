@@ -1945,3 +1945,578 @@ func ParseRequestTypeCustomFields(buffer bytes.Buffer, customField string) (map[
 
 	return customfieldsAsMap, nil
 }
+
+// ParseProjectCustomField parses the project picker customfield from the given buffer data
+// associated with the specified custom field ID and returns a ProjectScheme struct
+//
+// Parameters:
+//   - customfieldID: A string representing the unique identifier of the custom field.
+//   - buffer: A bytes.Buffer containing the serialized data to be parsed.
+//
+// Returns:
+//   - *ProjectScheme: the customfield value as ProjectScheme type
+//
+// Example usage:
+//
+//	customfieldID := "customfield_10050"
+//	buffer := bytes.NewBuffer([]byte{ /* Serialized data */ })
+//	project, err := ParseProjectCustomField(customfieldID, buffer)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// fmt.Println(project)
+func ParseProjectCustomField(buffer bytes.Buffer, customField string) (*ProjectScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	path := fmt.Sprintf("fields.%v", customField)
+
+	// Check if the buffer contains the "fields" object
+	if !raw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	// Check if the issue iteration contains information on the customfield selected,
+	// if not, continue
+	if raw.Get(path).Type == gjson.Null {
+		return nil, ErrNoProjectType
+	}
+
+	var project *ProjectScheme
+	if err := json.Unmarshal([]byte(raw.Get(path).String()), &project); err != nil {
+		return nil, ErrNoProjectType
+	}
+
+	return project, nil
+}
+
+// ParseProjectCustomFields extracts and parses the project picker customfield data from a given bytes.Buffer from multiple issues
+//
+// This function takes the name of the custom field to parse and a bytes.Buffer containing
+// JSON data representing the custom field values associated with different issues. It returns
+// a map where the key is the issue key and the value is a ProjectScheme struct.
+//
+// If the custom field data cannot be parsed successfully, an error is returned.
+//
+// Example Usage:
+//
+//	customFieldName := "customfield_10050"
+//	buffer := // Populate the buffer with JSON data
+//	customFields, err := ParseProjectCustomFields(customFieldName, buffer)
+//	if err != nil {
+//	    // Handle the error
+//	}
+//
+//	// Iterate through the parsed custom fields
+//	for issueKey, customFieldValue := range customFields {
+//	    fmt.Printf("Issue Key: %s\n", issueKey)
+//	    fmt.Printf("Custom Field Value: %+v\n", customFieldValue)
+//	}
+//
+// Parameters:
+//   - customField: The name of the project picker custom field to parse.
+//   - buffer: A bytes.Buffer containing JSON data representing custom field values.
+//
+// Returns:
+//   - map[string]*ProjectScheme: A map where the key is the issue key and the
+//     value is a ProjectScheme struct representing the parsed custom field value.
+//   - error: An error if there was a problem parsing the custom field data or if the JSON data
+//     did not conform to the expected structure.
+func ParseProjectCustomFields(buffer bytes.Buffer, customField string) (map[string]*ProjectScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+
+	// Check if the buffer contains the "issues" object
+	if !raw.Get("issues").Exists() {
+		return nil, ErrNoIssuesSlice
+	}
+
+	// Loop through each custom field, extract the information and stores the data on a map
+	customfieldsAsMap := make(map[string]*ProjectScheme)
+	raw.Get("issues").ForEach(func(key, value gjson.Result) bool {
+
+		path, issueKey := fmt.Sprintf("fields.%v", customField), value.Get("key").String()
+
+		// Check if the issue iteration contains information on the customfield selected,
+		// if not, continue
+		if value.Get(path).Type == gjson.Null {
+			return true
+		}
+
+		var customField *ProjectScheme
+		if err := json.Unmarshal([]byte(value.Get(path).String()), &customField); err != nil {
+			return true
+		}
+
+		customfieldsAsMap[issueKey] = customField
+		return true
+	})
+
+	// Check if the map processed contains elements
+	// if so, return an error interface
+	if len(customfieldsAsMap) == 0 {
+		return nil, ErrNoMapValues
+	}
+
+	return customfieldsAsMap, nil
+}
+
+// ParseVersionCustomField parses the single version picker customfield from the given buffer data
+// associated with the specified custom field ID and returns a VersionDetailScheme struct
+//
+// Parameters:
+//   - customfieldID: A string representing the unique identifier of the custom field.
+//   - buffer: A bytes.Buffer containing the serialized data to be parsed.
+//
+// Returns:
+//   - *VersionDetailScheme: the customfield value as VersionDetailScheme type
+//
+// Example usage:
+//
+//	customfieldID := "customfield_10051"
+//	buffer := bytes.NewBuffer([]byte{ /* Serialized data */ })
+//	version, err := ParseVersionCustomField(customfieldID, buffer)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// fmt.Println(version)
+func ParseVersionCustomField(buffer bytes.Buffer, customField string) (*VersionDetailScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	path := fmt.Sprintf("fields.%v", customField)
+
+	// Check if the buffer contains the "fields" object
+	if !raw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	// Check if the issue iteration contains information on the customfield selected,
+	// if not, continue
+	if raw.Get(path).Type == gjson.Null {
+		return nil, ErrNoVersionType
+	}
+
+	var version *VersionDetailScheme
+	if err := json.Unmarshal([]byte(raw.Get(path).String()), &version); err != nil {
+		return nil, ErrNoVersionType
+	}
+
+	return version, nil
+}
+
+// ParseVersionCustomFields extracts and parses the single version picker customfield data from a given bytes.Buffer from multiple issues
+//
+// This function takes the name of the custom field to parse and a bytes.Buffer containing
+// JSON data representing the custom field values associated with different issues. It returns
+// a map where the key is the issue key and the value is a VersionDetailScheme struct.
+//
+// If the custom field data cannot be parsed successfully, an error is returned.
+//
+// Example Usage:
+//
+//	customFieldName := "customfield_10051"
+//	buffer := // Populate the buffer with JSON data
+//	customFields, err := ParseVersionCustomFields(customFieldName, buffer)
+//	if err != nil {
+//	    // Handle the error
+//	}
+//
+//	// Iterate through the parsed custom fields
+//	for issueKey, customFieldValue := range customFields {
+//	    fmt.Printf("Issue Key: %s\n", issueKey)
+//	    fmt.Printf("Custom Field Value: %+v\n", customFieldValue)
+//	}
+//
+// Parameters:
+//   - customField: The name of the single version picker custom field to parse.
+//   - buffer: A bytes.Buffer containing JSON data representing custom field values.
+//
+// Returns:
+//   - map[string]*VersionDetailScheme: A map where the key is the issue key and the
+//     value is a VersionDetailScheme struct representing the parsed custom field value.
+//   - error: An error if there was a problem parsing the custom field data or if the JSON data
+//     did not conform to the expected structure.
+func ParseVersionCustomFields(buffer bytes.Buffer, customField string) (map[string]*VersionDetailScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+
+	// Check if the buffer contains the "issues" object
+	if !raw.Get("issues").Exists() {
+		return nil, ErrNoIssuesSlice
+	}
+
+	// Loop through each custom field, extract the information and stores the data on a map
+	customfieldsAsMap := make(map[string]*VersionDetailScheme)
+	raw.Get("issues").ForEach(func(key, value gjson.Result) bool {
+
+		path, issueKey := fmt.Sprintf("fields.%v", customField), value.Get("key").String()
+
+		// Check if the issue iteration contains information on the customfield selected,
+		// if not, continue
+		if value.Get(path).Type == gjson.Null {
+			return true
+		}
+
+		var customField *VersionDetailScheme
+		if err := json.Unmarshal([]byte(value.Get(path).String()), &customField); err != nil {
+			return true
+		}
+
+		customfieldsAsMap[issueKey] = customField
+		return true
+	})
+
+	// Check if the map processed contains elements
+	// if so, return an error interface
+	if len(customfieldsAsMap) == 0 {
+		return nil, ErrNoMapValues
+	}
+
+	return customfieldsAsMap, nil
+}
+
+// ParseADFCustomField parses the rich-text (Atlassian Document Format) customfield from the given buffer data
+// associated with the specified custom field ID and returns a CommentNodeScheme struct
+//
+// Parameters:
+//   - customfieldID: A string representing the unique identifier of the custom field.
+//   - buffer: A bytes.Buffer containing the serialized data to be parsed.
+//
+// Returns:
+//   - *CommentNodeScheme: the customfield value as CommentNodeScheme type
+//
+// Example usage:
+//
+//	customfieldID := "customfield_10052"
+//	buffer := bytes.NewBuffer([]byte{ /* Serialized data */ })
+//	aDF, err := ParseADFCustomField(customfieldID, buffer)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// fmt.Println(aDF)
+func ParseADFCustomField(buffer bytes.Buffer, customField string) (*CommentNodeScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	path := fmt.Sprintf("fields.%v", customField)
+
+	// Check if the buffer contains the "fields" object
+	if !raw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	// Check if the issue iteration contains information on the customfield selected,
+	// if not, continue
+	if raw.Get(path).Type == gjson.Null {
+		return nil, ErrNoADFType
+	}
+
+	var aDF *CommentNodeScheme
+	if err := json.Unmarshal([]byte(raw.Get(path).String()), &aDF); err != nil {
+		return nil, ErrNoADFType
+	}
+
+	return aDF, nil
+}
+
+// ParseADFCustomFields extracts and parses the rich-text (Atlassian Document Format) customfield data from a given bytes.Buffer from multiple issues
+//
+// This function takes the name of the custom field to parse and a bytes.Buffer containing
+// JSON data representing the custom field values associated with different issues. It returns
+// a map where the key is the issue key and the value is a CommentNodeScheme struct.
+//
+// If the custom field data cannot be parsed successfully, an error is returned.
+//
+// Example Usage:
+//
+//	customFieldName := "customfield_10052"
+//	buffer := // Populate the buffer with JSON data
+//	customFields, err := ParseADFCustomFields(customFieldName, buffer)
+//	if err != nil {
+//	    // Handle the error
+//	}
+//
+//	// Iterate through the parsed custom fields
+//	for issueKey, customFieldValue := range customFields {
+//	    fmt.Printf("Issue Key: %s\n", issueKey)
+//	    fmt.Printf("Custom Field Value: %+v\n", customFieldValue)
+//	}
+//
+// Parameters:
+//   - customField: The name of the rich-text (Atlassian Document Format) custom field to parse.
+//   - buffer: A bytes.Buffer containing JSON data representing custom field values.
+//
+// Returns:
+//   - map[string]*CommentNodeScheme: A map where the key is the issue key and the
+//     value is a CommentNodeScheme struct representing the parsed custom field value.
+//   - error: An error if there was a problem parsing the custom field data or if the JSON data
+//     did not conform to the expected structure.
+func ParseADFCustomFields(buffer bytes.Buffer, customField string) (map[string]*CommentNodeScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+
+	// Check if the buffer contains the "issues" object
+	if !raw.Get("issues").Exists() {
+		return nil, ErrNoIssuesSlice
+	}
+
+	// Loop through each custom field, extract the information and stores the data on a map
+	customfieldsAsMap := make(map[string]*CommentNodeScheme)
+	raw.Get("issues").ForEach(func(key, value gjson.Result) bool {
+
+		path, issueKey := fmt.Sprintf("fields.%v", customField), value.Get("key").String()
+
+		// Check if the issue iteration contains information on the customfield selected,
+		// if not, continue
+		if value.Get(path).Type == gjson.Null {
+			return true
+		}
+
+		var customField *CommentNodeScheme
+		if err := json.Unmarshal([]byte(value.Get(path).String()), &customField); err != nil {
+			return true
+		}
+
+		customfieldsAsMap[issueKey] = customField
+		return true
+	})
+
+	// Check if the map processed contains elements
+	// if so, return an error interface
+	if len(customfieldsAsMap) == 0 {
+		return nil, ErrNoMapValues
+	}
+
+	return customfieldsAsMap, nil
+}
+
+// ParseTeamCustomField parses the Atlassian team customfield from the given buffer data
+// associated with the specified custom field ID and returns a CustomFieldTeamScheme struct
+//
+// Parameters:
+//   - customfieldID: A string representing the unique identifier of the custom field.
+//   - buffer: A bytes.Buffer containing the serialized data to be parsed.
+//
+// Returns:
+//   - *CustomFieldTeamScheme: the customfield value as CustomFieldTeamScheme type
+//
+// Example usage:
+//
+//	customfieldID := "customfield_10001"
+//	buffer := bytes.NewBuffer([]byte{ /* Serialized data */ })
+//	team, err := ParseTeamCustomField(customfieldID, buffer)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// fmt.Println(team)
+func ParseTeamCustomField(buffer bytes.Buffer, customField string) (*CustomFieldTeamScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	path := fmt.Sprintf("fields.%v", customField)
+
+	// Check if the buffer contains the "fields" object
+	if !raw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	// Check if the issue iteration contains information on the customfield selected,
+	// if not, continue
+	if raw.Get(path).Type == gjson.Null {
+		return nil, ErrNoTeamType
+	}
+
+	var team *CustomFieldTeamScheme
+	if err := json.Unmarshal([]byte(raw.Get(path).String()), &team); err != nil {
+		return nil, ErrNoTeamType
+	}
+
+	return team, nil
+}
+
+// ParseTeamCustomFields extracts and parses the Atlassian team customfield data from a given bytes.Buffer from multiple issues
+//
+// This function takes the name of the custom field to parse and a bytes.Buffer containing
+// JSON data representing the custom field values associated with different issues. It returns
+// a map where the key is the issue key and the value is a CustomFieldTeamScheme struct.
+//
+// If the custom field data cannot be parsed successfully, an error is returned.
+//
+// Example Usage:
+//
+//	customFieldName := "customfield_10001"
+//	buffer := // Populate the buffer with JSON data
+//	customFields, err := ParseTeamCustomFields(customFieldName, buffer)
+//	if err != nil {
+//	    // Handle the error
+//	}
+//
+//	// Iterate through the parsed custom fields
+//	for issueKey, customFieldValue := range customFields {
+//	    fmt.Printf("Issue Key: %s\n", issueKey)
+//	    fmt.Printf("Custom Field Value: %+v\n", customFieldValue)
+//	}
+//
+// Parameters:
+//   - customField: The name of the Atlassian team custom field to parse.
+//   - buffer: A bytes.Buffer containing JSON data representing custom field values.
+//
+// Returns:
+//   - map[string]*CustomFieldTeamScheme: A map where the key is the issue key and the
+//     value is a CustomFieldTeamScheme struct representing the parsed custom field value.
+//   - error: An error if there was a problem parsing the custom field data or if the JSON data
+//     did not conform to the expected structure.
+func ParseTeamCustomFields(buffer bytes.Buffer, customField string) (map[string]*CustomFieldTeamScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+
+	// Check if the buffer contains the "issues" object
+	if !raw.Get("issues").Exists() {
+		return nil, ErrNoIssuesSlice
+	}
+
+	// Loop through each custom field, extract the information and stores the data on a map
+	customfieldsAsMap := make(map[string]*CustomFieldTeamScheme)
+	raw.Get("issues").ForEach(func(key, value gjson.Result) bool {
+
+		path, issueKey := fmt.Sprintf("fields.%v", customField), value.Get("key").String()
+
+		// Check if the issue iteration contains information on the customfield selected,
+		// if not, continue
+		if value.Get(path).Type == gjson.Null {
+			return true
+		}
+
+		var customField *CustomFieldTeamScheme
+		if err := json.Unmarshal([]byte(value.Get(path).String()), &customField); err != nil {
+			return true
+		}
+
+		customfieldsAsMap[issueKey] = customField
+		return true
+	})
+
+	// Check if the map processed contains elements
+	// if so, return an error interface
+	if len(customfieldsAsMap) == 0 {
+		return nil, ErrNoMapValues
+	}
+
+	return customfieldsAsMap, nil
+}
+
+// ParseParentLinkCustomField parses the Advanced Roadmaps parent link customfield from the given buffer data
+// associated with the specified custom field ID and returns a CustomFieldParentLinkScheme struct
+//
+// Parameters:
+//   - customfieldID: A string representing the unique identifier of the custom field.
+//   - buffer: A bytes.Buffer containing the serialized data to be parsed.
+//
+// Returns:
+//   - *CustomFieldParentLinkScheme: the customfield value as CustomFieldParentLinkScheme type
+//
+// Example usage:
+//
+//	customfieldID := "customfield_10053"
+//	buffer := bytes.NewBuffer([]byte{ /* Serialized data */ })
+//	parentLink, err := ParseParentLinkCustomField(customfieldID, buffer)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// fmt.Println(parentLink)
+func ParseParentLinkCustomField(buffer bytes.Buffer, customField string) (*CustomFieldParentLinkScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	path := fmt.Sprintf("fields.%v", customField)
+
+	// Check if the buffer contains the "fields" object
+	if !raw.Get("fields").Exists() {
+		return nil, ErrNoFieldInformation
+	}
+
+	// Check if the issue iteration contains information on the customfield selected,
+	// if not, continue
+	if raw.Get(path).Type == gjson.Null {
+		return nil, ErrNoParentLinkType
+	}
+
+	var parentLink *CustomFieldParentLinkScheme
+	if err := json.Unmarshal([]byte(raw.Get(path).String()), &parentLink); err != nil {
+		return nil, ErrNoParentLinkType
+	}
+
+	return parentLink, nil
+}
+
+// ParseParentLinkCustomFields extracts and parses the Advanced Roadmaps parent link customfield data from a given bytes.Buffer from multiple issues
+//
+// This function takes the name of the custom field to parse and a bytes.Buffer containing
+// JSON data representing the custom field values associated with different issues. It returns
+// a map where the key is the issue key and the value is a CustomFieldParentLinkScheme struct.
+//
+// If the custom field data cannot be parsed successfully, an error is returned.
+//
+// Example Usage:
+//
+//	customFieldName := "customfield_10053"
+//	buffer := // Populate the buffer with JSON data
+//	customFields, err := ParseParentLinkCustomFields(customFieldName, buffer)
+//	if err != nil {
+//	    // Handle the error
+//	}
+//
+//	// Iterate through the parsed custom fields
+//	for issueKey, customFieldValue := range customFields {
+//	    fmt.Printf("Issue Key: %s\n", issueKey)
+//	    fmt.Printf("Custom Field Value: %+v\n", customFieldValue)
+//	}
+//
+// Parameters:
+//   - customField: The name of the Advanced Roadmaps parent link custom field to parse.
+//   - buffer: A bytes.Buffer containing JSON data representing custom field values.
+//
+// Returns:
+//   - map[string]*CustomFieldParentLinkScheme: A map where the key is the issue key and the
+//     value is a CustomFieldParentLinkScheme struct representing the parsed custom field value.
+//   - error: An error if there was a problem parsing the custom field data or if the JSON data
+//     did not conform to the expected structure.
+func ParseParentLinkCustomFields(buffer bytes.Buffer, customField string) (map[string]*CustomFieldParentLinkScheme, error) {
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+
+	// Check if the buffer contains the "issues" object
+	if !raw.Get("issues").Exists() {
+		return nil, ErrNoIssuesSlice
+	}
+
+	// Loop through each custom field, extract the information and stores the data on a map
+	customfieldsAsMap := make(map[string]*CustomFieldParentLinkScheme)
+	raw.Get("issues").ForEach(func(key, value gjson.Result) bool {
+
+		path, issueKey := fmt.Sprintf("fields.%v", customField), value.Get("key").String()
+
+		// Check if the issue iteration contains information on the customfield selected,
+		// if not, continue
+		if value.Get(path).Type == gjson.Null {
+			return true
+		}
+
+		var customField *CustomFieldParentLinkScheme
+		if err := json.Unmarshal([]byte(value.Get(path).String()), &customField); err != nil {
+			return true
+		}
+
+		customfieldsAsMap[issueKey] = customField
+		return true
+	})
+
+	// Check if the map processed contains elements
+	// if so, return an error interface
+	if len(customfieldsAsMap) == 0 {
+		return nil, ErrNoMapValues
+	}
+
+	return customfieldsAsMap, nil
+}
@@ -6599,3 +6599,838 @@ func TestParseRequestTypeCustomFields(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProjectCustomField(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/10000","id":"10000","key":"KP","name":"Kanban Project"}}}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"no_fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/10000","id":"10000","key":"KP","name":"Kanban Project"}}}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"fields":{"customfield_10050":null}}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields":{"customfield_10050":[]}}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *ProjectScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: &ProjectScheme{
+				Self: "https://ctreminiom.atlassian.net/rest/api/3/project/10000",
+				ID:   "10000",
+				Key:  "KP",
+				Name: "Kanban Project",
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer no contains information",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoProjectType,
+		},
+
+		{
+			name: "when the buffer does not contains the fields object",
+			args: args{
+				buffer:      bufferMockedWithNoFields,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name: "when the buffer does not contains a valid field type",
+			args: args{
+				buffer:      bufferMockedWithInvalidType,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoProjectType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseProjectCustomField(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseProjectCustomField() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseProjectCustomField() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseProjectCustomField() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseProjectCustomFields(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/10000","id":"10000","key":"KP","name":"Kanban Project"}}},{"key":"KP-23","fields":{"customfield_10050":null}}]}`)
+
+	bufferMockedWithNoIssues := bytes.Buffer{}
+	bufferMockedWithNoIssues.WriteString(`{"no_issues":[{"key":"KP-22","fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/project/10000","id":"10000","key":"KP","name":"Kanban Project"}}}]}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":null}}]}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    map[string]*ProjectScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: map[string]*ProjectScheme{
+				"KP-22": {
+					Self: "https://ctreminiom.atlassian.net/rest/api/3/project/10000",
+					ID:   "10000",
+					Key:  "KP",
+					Name: "Kanban Project",
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer does not contain the issues object",
+			args: args{
+				buffer:      bufferMockedWithNoIssues,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the buffer contains null customfields",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoMapValues,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseProjectCustomFields(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseProjectCustomFields() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseProjectCustomFields() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseProjectCustomFields() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseVersionCustomField(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/version/10000","id":"10000","name":"v1.0","released":true}}}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"no_fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/version/10000","id":"10000","name":"v1.0","released":true}}}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"fields":{"customfield_10050":null}}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields":{"customfield_10050":[]}}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *VersionDetailScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: &VersionDetailScheme{
+				Self:     "https://ctreminiom.atlassian.net/rest/api/3/version/10000",
+				ID:       "10000",
+				Name:     "v1.0",
+				Released: true,
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer no contains information",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoVersionType,
+		},
+
+		{
+			name: "when the buffer does not contains the fields object",
+			args: args{
+				buffer:      bufferMockedWithNoFields,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name: "when the buffer does not contains a valid field type",
+			args: args{
+				buffer:      bufferMockedWithInvalidType,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoVersionType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseVersionCustomField(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseVersionCustomField() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseVersionCustomField() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseVersionCustomField() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseVersionCustomFields(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/version/10000","id":"10000","name":"v1.0","released":true}}},{"key":"KP-23","fields":{"customfield_10050":null}}]}`)
+
+	bufferMockedWithNoIssues := bytes.Buffer{}
+	bufferMockedWithNoIssues.WriteString(`{"no_issues":[{"key":"KP-22","fields":{"customfield_10050":{"self":"https://ctreminiom.atlassian.net/rest/api/3/version/10000","id":"10000","name":"v1.0","released":true}}}]}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":null}}]}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    map[string]*VersionDetailScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: map[string]*VersionDetailScheme{
+				"KP-22": {
+					Self:     "https://ctreminiom.atlassian.net/rest/api/3/version/10000",
+					ID:       "10000",
+					Name:     "v1.0",
+					Released: true,
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer does not contain the issues object",
+			args: args{
+				buffer:      bufferMockedWithNoIssues,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the buffer contains null customfields",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoMapValues,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseVersionCustomFields(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseVersionCustomFields() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseVersionCustomFields() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseVersionCustomFields() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseADFCustomField(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"fields":{"customfield_10050":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Rich text"}]}]}}}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"no_fields":{"customfield_10050":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Rich text"}]}]}}}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"fields":{"customfield_10050":null}}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields":{"customfield_10050":[]}}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *CommentNodeScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: &CommentNodeScheme{
+				Version: 1,
+				Type:    "doc",
+				Content: []*CommentNodeScheme{
+					{Type: "paragraph", Content: []*CommentNodeScheme{{Type: "text", Text: "Rich text"}}},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer no contains information",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoADFType,
+		},
+
+		{
+			name: "when the buffer does not contains the fields object",
+			args: args{
+				buffer:      bufferMockedWithNoFields,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name: "when the buffer does not contains a valid field type",
+			args: args{
+				buffer:      bufferMockedWithInvalidType,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoADFType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseADFCustomField(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseADFCustomField() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseADFCustomField() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseADFCustomField() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseADFCustomFields(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Rich text"}]}]}}},{"key":"KP-23","fields":{"customfield_10050":null}}]}`)
+
+	bufferMockedWithNoIssues := bytes.Buffer{}
+	bufferMockedWithNoIssues.WriteString(`{"no_issues":[{"key":"KP-22","fields":{"customfield_10050":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Rich text"}]}]}}}]}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":null}}]}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    map[string]*CommentNodeScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: map[string]*CommentNodeScheme{
+				"KP-22": {
+					Version: 1,
+					Type:    "doc",
+					Content: []*CommentNodeScheme{
+						{Type: "paragraph", Content: []*CommentNodeScheme{{Type: "text", Text: "Rich text"}}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer does not contain the issues object",
+			args: args{
+				buffer:      bufferMockedWithNoIssues,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the buffer contains null customfields",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoMapValues,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseADFCustomFields(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseADFCustomFields() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseADFCustomFields() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseADFCustomFields() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseTeamCustomField(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"fields":{"customfield_10050":{"id":"36885b3c-1bf0-4f85-a357-c5b858c31de4","name":"Platform","isVisible":true,"isVerified":false,"isShared":true}}}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"no_fields":{"customfield_10050":{"id":"36885b3c-1bf0-4f85-a357-c5b858c31de4","name":"Platform","isVisible":true,"isVerified":false,"isShared":true}}}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"fields":{"customfield_10050":null}}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields":{"customfield_10050":[]}}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *CustomFieldTeamScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: &CustomFieldTeamScheme{
+				ID:        "36885b3c-1bf0-4f85-a357-c5b858c31de4",
+				Name:      "Platform",
+				IsVisible: true,
+				IsShared:  true,
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer no contains information",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoTeamType,
+		},
+
+		{
+			name: "when the buffer does not contains the fields object",
+			args: args{
+				buffer:      bufferMockedWithNoFields,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name: "when the buffer does not contains a valid field type",
+			args: args{
+				buffer:      bufferMockedWithInvalidType,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoTeamType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseTeamCustomField(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseTeamCustomField() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseTeamCustomField() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseTeamCustomField() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseTeamCustomFields(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":{"id":"36885b3c-1bf0-4f85-a357-c5b858c31de4","name":"Platform","isVisible":true,"isVerified":false,"isShared":true}}},{"key":"KP-23","fields":{"customfield_10050":null}}]}`)
+
+	bufferMockedWithNoIssues := bytes.Buffer{}
+	bufferMockedWithNoIssues.WriteString(`{"no_issues":[{"key":"KP-22","fields":{"customfield_10050":{"id":"36885b3c-1bf0-4f85-a357-c5b858c31de4","name":"Platform","isVisible":true,"isVerified":false,"isShared":true}}}]}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":null}}]}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    map[string]*CustomFieldTeamScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: map[string]*CustomFieldTeamScheme{
+				"KP-22": {
+					ID:        "36885b3c-1bf0-4f85-a357-c5b858c31de4",
+					Name:      "Platform",
+					IsVisible: true,
+					IsShared:  true,
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer does not contain the issues object",
+			args: args{
+				buffer:      bufferMockedWithNoIssues,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the buffer contains null customfields",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoMapValues,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseTeamCustomFields(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseTeamCustomFields() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseTeamCustomFields() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseTeamCustomFields() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseParentLinkCustomField(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"fields":{"customfield_10050":{"hasEpicLinkFieldDependency":false,"showField":true,"data":{"id":10035,"issueKey":"KP-22","keyNum":22,"summary":"Initiative","projectId":10000}}}}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"no_fields":{"customfield_10050":{"hasEpicLinkFieldDependency":false,"showField":true,"data":{"id":10035,"issueKey":"KP-22","keyNum":22,"summary":"Initiative","projectId":10000}}}}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"fields":{"customfield_10050":null}}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields":{"customfield_10050":[]}}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *CustomFieldParentLinkScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: &CustomFieldParentLinkScheme{
+				ShowField: true,
+				Data: &CustomFieldParentLinkDataScheme{
+					ID:        10035,
+					IssueKey:  "KP-22",
+					KeyNum:    22,
+					Summary:   "Initiative",
+					ProjectID: 10000,
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer no contains information",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoParentLinkType,
+		},
+
+		{
+			name: "when the buffer does not contains the fields object",
+			args: args{
+				buffer:      bufferMockedWithNoFields,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name: "when the buffer does not contains a valid field type",
+			args: args{
+				buffer:      bufferMockedWithInvalidType,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoParentLinkType,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseParentLinkCustomField(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseParentLinkCustomField() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseParentLinkCustomField() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseParentLinkCustomField() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
+
+func TestParseParentLinkCustomFields(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":{"hasEpicLinkFieldDependency":false,"showField":true,"data":{"id":10035,"issueKey":"KP-22","keyNum":22,"summary":"Initiative","projectId":10000}}}},{"key":"KP-23","fields":{"customfield_10050":null}}]}`)
+
+	bufferMockedWithNoIssues := bytes.Buffer{}
+	bufferMockedWithNoIssues.WriteString(`{"no_issues":[{"key":"KP-22","fields":{"customfield_10050":{"hasEpicLinkFieldDependency":false,"showField":true,"data":{"id":10035,"issueKey":"KP-22","keyNum":22,"summary":"Initiative","projectId":10000}}}}]}`)
+
+	bufferMockedWithNoInfo := bytes.Buffer{}
+	bufferMockedWithNoInfo.WriteString(`{"issues":[{"key":"KP-22","fields":{"customfield_10050":null}}]}`)
+
+	type args struct {
+		buffer      bytes.Buffer
+		customField string
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    map[string]*CustomFieldParentLinkScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the buffer contains information",
+			args: args{
+				buffer:      bufferMocked,
+				customField: "customfield_10050",
+			},
+			want: map[string]*CustomFieldParentLinkScheme{
+				"KP-22": {
+					ShowField: true,
+					Data: &CustomFieldParentLinkDataScheme{
+						ID:        10035,
+						IssueKey:  "KP-22",
+						KeyNum:    22,
+						Summary:   "Initiative",
+						ProjectID: 10000,
+					},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "when the buffer does not contain the issues object",
+			args: args{
+				buffer:      bufferMockedWithNoIssues,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoIssuesSlice,
+		},
+
+		{
+			name: "when the buffer contains null customfields",
+			args: args{
+				buffer:      bufferMockedWithNoInfo,
+				customField: "customfield_10050",
+			},
+			wantErr: true,
+			Err:     ErrNoMapValues,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseParentLinkCustomFields(testCase.args.buffer, testCase.args.customField)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseParentLinkCustomFields() error = %v, wantErr %v", err, testCase.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ParseParentLinkCustomFields() got = %v, want %v", got, testCase.want)
+			}
+			if !reflect.DeepEqual(err, testCase.Err) {
+				t.Errorf("ParseParentLinkCustomFields() got = (%v), want (%v)", err, testCase.Err)
+			}
+		})
+	}
+}
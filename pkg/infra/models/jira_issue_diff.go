@@ -0,0 +1,375 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// IssueDiffScheme represents the minimal edit payload required to move an issue to a desired state.
+//
+// The Fields and Operations collections can be sent to the issue Update method, along with an empty
+// IssueScheme payload, so only the fields that changed are edited.
+type IssueDiffScheme struct {
+	Fields     *CustomFields     // The fields to set, used for the single value fields.
+	Operations *UpdateOperations // The add/remove operations, used for the multi value fields.
+	Changed    []string          // The IDs of the fields that changed, sorted alphabetically.
+}
+
+// HasChanges reports whether the desired state differs from the current one.
+func (d *IssueDiffScheme) HasChanges() bool {
+	return len(d.Changed) != 0
+}
+
+// issueDiffReadOnlyFields contains the fields that cannot be edited using the update endpoint.
+var issueDiffReadOnlyFields = map[string]bool{
+	"aggregateprogress":        true,
+	"aggregatetimespent":       true,
+	"attachment":               true,
+	"comment":                  true,
+	"created":                  true,
+	"creator":                  true,
+	"issuelinks":               true,
+	"lastViewed":               true,
+	"progress":                 true,
+	"project":                  true,
+	"resolutiondate":           true,
+	"status":                   true,
+	"statuscategorychangedate": true,
+	"subtasks":                 true,
+	"timespent":                true,
+	"updated":                  true,
+	"votes":                    true,
+	"watches":                  true,
+	"workratio":                true,
+	"worklog":                  true,
+}
+
+// issueDiffIdentityKeys contains the keys used to identify the values of the object fields.
+var issueDiffIdentityKeys = []string{"accountId", "id", "key", "name", "value"}
+
+// DiffIssue compares the current state of an issue with the desired one and returns the minimal
+// edit payload required to apply the desired state.
+//
+// Only the fields set on the desired issue are compared, the fields not set are left untouched.
+// The multi value fields (labels, components, versions, multi-selects) produce add/remove operations
+// instead of replacing the whole value, so concurrent edits on the same field are not clobbered.
+func DiffIssue(current, desired *IssueScheme) (*IssueDiffScheme, error) {
+
+	if current == nil || desired == nil {
+		return nil, ErrNoIssueScheme
+	}
+
+	currentFields, err := issueSchemeFieldsAsMap(current.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := issueSchemeFieldsAsMap(desired.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffIssueFields(currentFields, desiredFields)
+}
+
+func issueSchemeFieldsAsMap(fields *IssueFieldsScheme) (map[string]interface{}, error) {
+
+	if fields == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	copied := *fields
+	copied.Resolutiondate, copied.StatusCategoryChangeDate, copied.Created, copied.Updated = nil, nil, nil, nil
+	copied.DueDate = nil
+
+	return issueFieldsAsMap(&copied, fields.DueDate)
+}
+
+// DiffIssueV2 compares the current state of an issue with the desired one, using the v2 issue scheme.
+//
+// See DiffIssue for more details.
+func DiffIssueV2(current, desired *IssueSchemeV2) (*IssueDiffScheme, error) {
+
+	if current == nil || desired == nil {
+		return nil, ErrNoIssueScheme
+	}
+
+	currentFields, err := issueSchemeV2FieldsAsMap(current.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := issueSchemeV2FieldsAsMap(desired.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffIssueFields(currentFields, desiredFields)
+}
+
+func issueSchemeV2FieldsAsMap(fields *IssueFieldsSchemeV2) (map[string]interface{}, error) {
+
+	if fields == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	copied := *fields
+	copied.ResolutionDate, copied.StatusCategoryChangeDate, copied.Created, copied.Updated = nil, nil, nil, nil
+	copied.DueDate = nil
+
+	return issueFieldsAsMap(&copied, fields.DueDate)
+}
+
+// DiffIssueFields compares two issue "fields" objects and returns the minimal edit payload.
+//
+// Use this function when the custom fields are part of the comparison, e.g. using the "fields" object of
+// the ResponseScheme.Bytes of the current issue and the "fields" object returned by IssueScheme.MergeCustomFields
+// for the desired state. A desired field set to nil clears the current value.
+func DiffIssueFields(current, desired map[string]interface{}) (*IssueDiffScheme, error) {
+
+	current, err := normalizeIssueFields(current)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err = normalizeIssueFields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &IssueDiffScheme{
+		Fields:     &CustomFields{},
+		Operations: &UpdateOperations{},
+	}
+
+	fieldIDs := make([]string, 0, len(desired))
+	for fieldID := range desired {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+
+	sort.Strings(fieldIDs)
+
+	for _, fieldID := range fieldIDs {
+
+		if issueDiffReadOnlyFields[fieldID] {
+			continue
+		}
+
+		currentValue, desiredValue := current[fieldID], desired[fieldID]
+
+		if operations, ok := diffIssueArrayField(currentValue, desiredValue); ok {
+
+			if len(operations) == 0 {
+				continue
+			}
+
+			if err := diff.Operations.AddMultiRawOperation(fieldID, operations); err != nil {
+				return nil, err
+			}
+
+			diff.Changed = append(diff.Changed, fieldID)
+			continue
+		}
+
+		if sameIssueFieldValue(currentValue, desiredValue) {
+			continue
+		}
+
+		var valueNode = map[string]interface{}{}
+		valueNode[fieldID] = desiredValue
+
+		var fieldsNode = map[string]interface{}{}
+		fieldsNode["fields"] = valueNode
+
+		diff.Fields.Fields = append(diff.Fields.Fields, fieldsNode)
+		diff.Changed = append(diff.Changed, fieldID)
+	}
+
+	return diff, nil
+}
+
+// issueFieldsAsMap converts the issue fields into a map.
+// The date fields are formatted manually, their JSON encoding is not quoted.
+func issueFieldsAsMap(fields interface{}, dueDate *DateScheme) (map[string]interface{}, error) {
+
+	fieldsAsMap, err := normalizeIssueFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if dueDate != nil {
+		fieldsAsMap["duedate"] = time.Time(*dueDate).Format(DateFormat)
+	}
+
+	return fieldsAsMap, nil
+}
+
+// normalizeIssueFields converts a value into a map using its JSON representation,
+// so the values can be compared regardless of their Go types.
+func normalizeIssueFields(fields interface{}) (map[string]interface{}, error) {
+
+	fieldsAsBytes, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsAsMap := make(map[string]interface{})
+	if err := json.Unmarshal(fieldsAsBytes, &fieldsAsMap); err != nil {
+		return nil, err
+	}
+
+	return fieldsAsMap, nil
+}
+
+// diffIssueArrayField returns the add/remove operations of a multi value field.
+// The second value is false when the field cannot be edited using operations.
+func diffIssueArrayField(current, desired interface{}) ([]map[string]interface{}, bool) {
+
+	desiredValues, ok := desired.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	var currentValues []interface{}
+	if current != nil {
+
+		if currentValues, ok = current.([]interface{}); !ok {
+			return nil, false
+		}
+	}
+
+	for _, value := range append(append([]interface{}{}, currentValues...), desiredValues...) {
+		if !isIssueFieldElement(value) {
+			return nil, false
+		}
+	}
+
+	var operations []map[string]interface{}
+	for _, value := range currentValues {
+		if !containsIssueFieldElement(desiredValues, value) {
+			operations = append(operations, map[string]interface{}{"remove": issueFieldReference(value)})
+		}
+	}
+
+	var added []interface{}
+	for _, value := range desiredValues {
+		if !containsIssueFieldElement(currentValues, value) && !containsIssueFieldElement(added, value) {
+			operations = append(operations, map[string]interface{}{"add": issueFieldReference(value)})
+			added = append(added, value)
+		}
+	}
+
+	return operations, true
+}
+
+// isIssueFieldElement reports whether a value can be identified inside a multi value field.
+func isIssueFieldElement(value interface{}) bool {
+
+	switch typed := value.(type) {
+	case string, float64:
+		return true
+
+	case map[string]interface{}:
+		for _, key := range issueDiffIdentityKeys {
+			if identity, ok := typed[key]; ok && identity != nil && identity != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// containsIssueFieldElement reports whether the values contain the element.
+// The objects match when they share an identity key with the same value, e.g. {"name": "Backend"}
+// matches {"id": "10000", "name": "Backend"}.
+func containsIssueFieldElement(values []interface{}, element interface{}) bool {
+
+	elementNode, isNode := element.(map[string]interface{})
+
+	for _, value := range values {
+
+		if !isNode {
+			if reflect.DeepEqual(value, element) {
+				return true
+			}
+
+			continue
+		}
+
+		valueNode, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range issueDiffIdentityKeys {
+
+			identity, ok := elementNode[key]
+			if !ok || identity == nil || identity == "" {
+				continue
+			}
+
+			if valueIdentity, ok := valueNode[key]; ok && reflect.DeepEqual(valueIdentity, identity) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// issueFieldReference reduces an element of a multi value field to its identity key.
+func issueFieldReference(value interface{}) interface{} {
+
+	node, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	for _, key := range issueDiffIdentityKeys {
+		if identity, ok := node[key]; ok && identity != nil && identity != "" {
+			return map[string]interface{}{key: identity}
+		}
+	}
+
+	return value
+}
+
+// sameIssueFieldValue reports whether the current value already contains the desired one.
+// The objects are compared using the desired keys only, e.g. {"accountId": "..."} matches a full user object.
+func sameIssueFieldValue(current, desired interface{}) bool {
+
+	switch typed := desired.(type) {
+	case map[string]interface{}:
+		currentNode, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for key, value := range typed {
+			if !sameIssueFieldValue(currentNode[key], value) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		currentValues, ok := current.([]interface{})
+		if !ok || len(currentValues) != len(typed) {
+			return false
+		}
+
+		for index, value := range typed {
+			if !sameIssueFieldValue(currentValues[index], value) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(current, desired)
+}
@@ -0,0 +1,184 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffIssue(t *testing.T) {
+
+	created := DateTimeScheme(time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC))
+	dueDate := DateScheme(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	newDueDate := DateScheme(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	currentMocked := &IssueScheme{
+		Key: "KP-1",
+		Fields: &IssueFieldsScheme{
+			Summary:  "Current summary",
+			Labels:   []string{"backend", "legacy"},
+			Assignee: &UserScheme{AccountID: "account-id-1", DisplayName: "Carlos"},
+			Priority: &PriorityScheme{ID: "3", Name: "Medium"},
+			Components: []*ComponentScheme{
+				{ID: "10000", Name: "API"},
+				{ID: "10001", Name: "UI"},
+			},
+			Status:  &StatusScheme{Name: "To Do"},
+			Created: &created,
+			DueDate: &dueDate,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		current     *IssueScheme
+		desired     *IssueScheme
+		wantFields  *CustomFields
+		wantOps     *UpdateOperations
+		wantChanged []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:    "when the desired state changes scalar and multi value fields",
+			current: currentMocked,
+			desired: &IssueScheme{
+				Fields: &IssueFieldsScheme{
+					Summary:    "Current summary",
+					Labels:     []string{"backend", "api"},
+					Assignee:   &UserScheme{AccountID: "account-id-2"},
+					Priority:   &PriorityScheme{ID: "3"},
+					Components: []*ComponentScheme{{Name: "API"}, {Name: "Docs"}},
+					Status:     &StatusScheme{Name: "Done"},
+					DueDate:    &newDueDate,
+				},
+			},
+			wantFields: &CustomFields{Fields: []map[string]interface{}{
+				{"fields": map[string]interface{}{"assignee": map[string]interface{}{"accountId": "account-id-2"}}},
+				{"fields": map[string]interface{}{"duedate": "2024-03-01"}},
+			}},
+			wantOps: &UpdateOperations{Fields: []map[string]interface{}{
+				{"update": map[string]interface{}{"components": []map[string]interface{}{
+					{"remove": map[string]interface{}{"id": "10001"}},
+					{"add": map[string]interface{}{"name": "Docs"}},
+				}}},
+				{"update": map[string]interface{}{"labels": []map[string]interface{}{
+					{"remove": "legacy"},
+					{"add": "api"},
+				}}},
+			}},
+			wantChanged: []string{"assignee", "components", "duedate", "labels"},
+		},
+
+		{
+			name:    "when the desired state matches the current one",
+			current: currentMocked,
+			desired: &IssueScheme{
+				Fields: &IssueFieldsScheme{
+					Summary:  "Current summary",
+					Labels:   []string{"legacy", "backend"},
+					Assignee: &UserScheme{AccountID: "account-id-1"},
+				},
+			},
+			wantFields: &CustomFields{},
+			wantOps:    &UpdateOperations{},
+		},
+
+		{
+			name:    "when the current issue is not provided",
+			desired: &IssueScheme{},
+			wantErr: true,
+			Err:     ErrNoIssueScheme,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := DiffIssue(testCase.current, testCase.desired)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantFields, got.Fields)
+			assert.Equal(t, testCase.wantOps, got.Operations)
+			assert.Equal(t, testCase.wantChanged, got.Changed)
+			assert.Equal(t, len(testCase.wantChanged) != 0, got.HasChanges())
+		})
+	}
+}
+
+func TestDiffIssueV2(t *testing.T) {
+
+	current := &IssueSchemeV2{
+		Fields: &IssueFieldsSchemeV2{
+			Summary:     "Current summary",
+			Description: "Current description",
+			FixVersions: []*VersionScheme{{ID: "10000", Name: "v1.0"}},
+		},
+	}
+
+	desired := &IssueSchemeV2{
+		Fields: &IssueFieldsSchemeV2{
+			Description: "New description",
+			FixVersions: []*VersionScheme{{ID: "10000"}, {ID: "10001"}},
+		},
+	}
+
+	got, err := DiffIssueV2(current, desired)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"description", "fixVersions"}, got.Changed)
+	assert.Equal(t, &CustomFields{Fields: []map[string]interface{}{
+		{"fields": map[string]interface{}{"description": "New description"}},
+	}}, got.Fields)
+	assert.Equal(t, &UpdateOperations{Fields: []map[string]interface{}{
+		{"update": map[string]interface{}{"fixVersions": []map[string]interface{}{
+			{"add": map[string]interface{}{"id": "10001"}},
+		}}},
+	}}, got.Operations)
+
+	_, err = DiffIssueV2(current, nil)
+	assert.ErrorIs(t, err, ErrNoIssueScheme)
+}
+
+func TestDiffIssueFields(t *testing.T) {
+
+	current := map[string]interface{}{
+		"customfield_10001": []interface{}{
+			map[string]interface{}{"id": "10040", "value": "High"},
+		},
+		"customfield_10002": map[string]interface{}{"value": "America", "child": map[string]interface{}{"value": "US"}},
+		"customfield_10003": "Text",
+	}
+
+	desired := &IssueScheme{}
+	customFields := &CustomFields{}
+	assert.NoError(t, customFields.MultiSelect("customfield_10001", []string{"High", "Low"}))
+	assert.NoError(t, customFields.Cascading("customfield_10002", "America", "US"))
+	assert.NoError(t, customFields.Raw("customfield_10004", 4))
+
+	payload, err := desired.MergeCustomFields(customFields)
+	assert.NoError(t, err)
+
+	desiredFields := payload["fields"].(map[string]interface{})
+	desiredFields["customfield_10003"] = nil
+
+	got, err := DiffIssueFields(current, desiredFields)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"customfield_10001", "customfield_10003", "customfield_10004"}, got.Changed)
+	assert.Equal(t, &CustomFields{Fields: []map[string]interface{}{
+		{"fields": map[string]interface{}{"customfield_10003": nil}},
+		{"fields": map[string]interface{}{"customfield_10004": float64(4)}},
+	}}, got.Fields)
+	assert.Equal(t, &UpdateOperations{Fields: []map[string]interface{}{
+		{"update": map[string]interface{}{"customfield_10001": []map[string]interface{}{
+			{"add": map[string]interface{}{"value": "Low"}},
+		}}},
+	}}, got.Operations)
+}
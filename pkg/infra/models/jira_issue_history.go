@@ -0,0 +1,336 @@
+package models
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IssueFieldValueScheme represents a value of an issue field, using the changelog representation.
+type IssueFieldValueScheme struct {
+	ID     string `json:"id,omitempty"`     // The raw value, e.g. the status ID or the user account ID.
+	String string `json:"string,omitempty"` // The display value, e.g. the status name or the user display name.
+}
+
+// IssueFieldStateScheme represents the value of an issue field at a point in time.
+type IssueFieldStateScheme struct {
+	FieldID string                   `json:"fieldId,omitempty"` // The ID of the field.
+	Field   string                   `json:"field,omitempty"`   // The name of the field, as recorded on the changelog.
+	Value   *IssueFieldValueScheme   `json:"value,omitempty"`   // The value of the single value fields, nil when the field was empty.
+	Values  []*IssueFieldValueScheme `json:"values,omitempty"`  // The values of the multi value fields (components, versions, attachments).
+	Raw     interface{}              `json:"raw,omitempty"`     // The current JSON value, only set when the field didn't change after the timestamp.
+}
+
+// IssueSnapshotScheme represents the fields of an issue at a point in time.
+type IssueSnapshotScheme struct {
+	At     time.Time                         `json:"at"`     // The timestamp of the snapshot.
+	Fields map[string]*IssueFieldStateScheme `json:"fields"` // The fields of the issue, keyed by field ID.
+}
+
+// IssueTimelineEntryScheme represents a change of an issue field.
+type IssueTimelineEntryScheme struct {
+	At         time.Time `json:"at"`                   // The time of the change.
+	HistoryID  string    `json:"historyId,omitempty"`  // The ID of the changelog history.
+	AccountID  string    `json:"accountId,omitempty"`  // The account ID of the author of the change.
+	Author     string    `json:"author,omitempty"`     // The display name of the author of the change.
+	FieldID    string    `json:"fieldId,omitempty"`    // The ID of the field.
+	Field      string    `json:"field,omitempty"`      // The name of the field.
+	From       string    `json:"from,omitempty"`       // The previous raw value.
+	FromString string    `json:"fromString,omitempty"` // The previous display value.
+	To         string    `json:"to,omitempty"`         // The new raw value.
+	ToString   string    `json:"toString,omitempty"`   // The new display value.
+}
+
+// issueHistoryMultiValueFields contains the fields whose changelog items add or remove a single value,
+// instead of recording the whole value of the field.
+var issueHistoryMultiValueFields = map[string]bool{
+	"attachment":  true,
+	"components":  true,
+	"fixVersions": true,
+	"versions":    true,
+}
+
+// BuildIssueTimeline flattens the changelog histories into a list of field changes, sorted by time,
+// starting from the oldest one.
+func BuildIssueTimeline(histories []*IssueChangelogHistoryScheme) ([]*IssueTimelineEntryScheme, error) {
+
+	var timeline []*IssueTimelineEntryScheme
+	for _, history := range histories {
+
+		if history == nil {
+			continue
+		}
+
+		at, err := parseIssueTime(history.Created)
+		if err != nil {
+			return nil, fmt.Errorf("jira: unable to parse the history %v creation time: %w", history.ID, err)
+		}
+
+		for _, item := range history.Items {
+
+			if item == nil {
+				continue
+			}
+
+			entry := &IssueTimelineEntryScheme{
+				At:         at,
+				HistoryID:  history.ID,
+				FieldID:    issueHistoryFieldID(item),
+				Field:      item.Field,
+				From:       item.From,
+				FromString: item.FromString,
+				To:         item.To,
+				ToString:   item.ToString,
+			}
+
+			if history.Author != nil {
+				entry.AccountID, entry.Author = history.Author.AccountID, history.Author.DisplayName
+			}
+
+			timeline = append(timeline, entry)
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool { return timeline[i].At.Before(timeline[j].At) })
+
+	return timeline, nil
+}
+
+// WriteIssueTimelineCSV writes the timeline as CSV, including a header row.
+func WriteIssueTimelineCSV(w io.Writer, timeline []*IssueTimelineEntryScheme) error {
+
+	writer := csv.NewWriter(w)
+
+	header := []string{"at", "historyId", "accountId", "author", "fieldId", "field", "from", "fromString", "to", "toString"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, entry := range timeline {
+
+		record := []string{
+			entry.At.Format(time.RFC3339), entry.HistoryID, entry.AccountID, entry.Author, entry.FieldID,
+			entry.Field, entry.From, entry.FromString, entry.To, entry.ToString,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReconstructIssue returns the fields of the issue at the timestamp provided, replaying the changelog histories
+// backwards from the current state of the issue.
+//
+// The histories must contain the full changelog of the issue, use the changelog service to fetch it as the
+// "changelog" expand is truncated. When the histories are nil, the changelog of the issue is used.
+// The ErrIssueNotCreated error is returned when the issue was created after the timestamp.
+func ReconstructIssue(issue *IssueScheme, histories []*IssueChangelogHistoryScheme, at time.Time) (*IssueSnapshotScheme, error) {
+
+	if issue == nil {
+		return nil, ErrNoIssueScheme
+	}
+
+	if histories == nil && issue.Changelog != nil {
+		histories = issue.Changelog.Histories
+	}
+
+	if issue.Fields != nil && issue.Fields.Created != nil && at.Before(time.Time(*issue.Fields.Created)) {
+		return nil, ErrIssueNotCreated
+	}
+
+	current, err := issueSchemeFieldsAsMap(issue.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReconstructIssueFields(current, histories, at)
+}
+
+// ReconstructIssueFields returns the fields at the timestamp provided, replaying the changelog histories
+// backwards from the current "fields" object of an issue, e.g. the "fields" object of the
+// ResponseScheme.Bytes when the custom fields must be included.
+func ReconstructIssueFields(current map[string]interface{}, histories []*IssueChangelogHistoryScheme, at time.Time) (*IssueSnapshotScheme, error) {
+
+	timeline, err := BuildIssueTimeline(histories)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &IssueSnapshotScheme{At: at, Fields: make(map[string]*IssueFieldStateScheme)}
+
+	for fieldID, raw := range current {
+
+		state := &IssueFieldStateScheme{FieldID: fieldID, Raw: raw}
+
+		if issueHistoryMultiValueFields[fieldID] {
+			state.Values = issueFieldValuesFromRaw(raw)
+		} else {
+			state.Value = issueFieldValueFromRaw(raw)
+		}
+
+		snapshot.Fields[fieldID] = state
+	}
+
+	// The single value fields take the value recorded by the last change, so the raw and display values
+	// use the same representation regardless of the change being replayed or not.
+	for _, entry := range timeline {
+
+		state := snapshot.field(entry)
+		if !issueHistoryMultiValueFields[entry.FieldID] {
+			state.Value = newIssueFieldValue(entry.To, entry.ToString)
+		}
+	}
+
+	for index := len(timeline) - 1; index >= 0; index-- {
+
+		entry := timeline[index]
+		if !entry.At.After(at) {
+			break
+		}
+
+		state := snapshot.field(entry)
+		state.Raw = nil
+
+		if !issueHistoryMultiValueFields[entry.FieldID] {
+			state.Value = newIssueFieldValue(entry.From, entry.FromString)
+			continue
+		}
+
+		if added := newIssueFieldValue(entry.To, entry.ToString); added != nil {
+			state.Values = removeIssueFieldValue(state.Values, added)
+		}
+
+		if removed := newIssueFieldValue(entry.From, entry.FromString); removed != nil {
+			state.Values = append(state.Values, removed)
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (s *IssueSnapshotScheme) field(entry *IssueTimelineEntryScheme) *IssueFieldStateScheme {
+
+	state, ok := s.Fields[entry.FieldID]
+	if !ok {
+		state = &IssueFieldStateScheme{FieldID: entry.FieldID}
+		s.Fields[entry.FieldID] = state
+	}
+
+	state.Field = entry.Field
+	return state
+}
+
+func issueHistoryFieldID(item *IssueChangelogHistoryItemScheme) string {
+
+	if item.FieldID != "" {
+		return item.FieldID
+	}
+
+	return item.Field
+}
+
+func newIssueFieldValue(id, display string) *IssueFieldValueScheme {
+
+	if id == "" && display == "" {
+		return nil
+	}
+
+	return &IssueFieldValueScheme{ID: id, String: display}
+}
+
+func removeIssueFieldValue(values []*IssueFieldValueScheme, value *IssueFieldValueScheme) []*IssueFieldValueScheme {
+
+	for index, candidate := range values {
+
+		if (value.ID != "" && candidate.ID == value.ID) || (value.ID == "" && candidate.String == value.String) {
+			return append(values[:index:index], values[index+1:]...)
+		}
+	}
+
+	return values
+}
+
+// issueFieldValueFromRaw converts a JSON value into the changelog representation.
+func issueFieldValueFromRaw(raw interface{}) *IssueFieldValueScheme {
+
+	switch typed := raw.(type) {
+	case nil:
+		return nil
+
+	case string:
+		return newIssueFieldValue("", typed)
+
+	case float64:
+		return newIssueFieldValue("", strconv.FormatFloat(typed, 'f', -1, 64))
+
+	case bool:
+		return newIssueFieldValue("", strconv.FormatBool(typed))
+
+	case map[string]interface{}:
+		return newIssueFieldValue(
+			issueFieldStringKey(typed, "id", "accountId", "key"),
+			issueFieldStringKey(typed, "name", "displayName", "value", "key"),
+		)
+
+	case []interface{}:
+		var values []string
+		for _, value := range issueFieldValuesFromRaw(typed) {
+			values = append(values, value.String)
+		}
+
+		return newIssueFieldValue("", strings.Join(values, " "))
+	}
+
+	return nil
+}
+
+func issueFieldValuesFromRaw(raw interface{}) []*IssueFieldValueScheme {
+
+	elements, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var values []*IssueFieldValueScheme
+	for _, element := range elements {
+
+		if node, ok := element.(map[string]interface{}); ok {
+
+			value := newIssueFieldValue(
+				issueFieldStringKey(node, "id", "accountId", "key"),
+				issueFieldStringKey(node, "name", "filename", "displayName", "value", "key"),
+			)
+
+			if value != nil {
+				values = append(values, value)
+			}
+
+			continue
+		}
+
+		if value := issueFieldValueFromRaw(element); value != nil {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func issueFieldStringKey(node map[string]interface{}, keys ...string) string {
+
+	for _, key := range keys {
+		if value, ok := node[key].(string); ok && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func issueHistoryMocked() []*IssueChangelogHistoryScheme {
+
+	return []*IssueChangelogHistoryScheme{
+		{
+			ID:      "10002",
+			Author:  &IssueChangelogAuthor{AccountID: "account-id-2", DisplayName: "Eve"},
+			Created: "2024-01-10T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "status", FieldID: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+				{Field: "Component", FieldID: "components", From: "10001", FromString: "UI"},
+			},
+		},
+		{
+			ID:      "10001",
+			Author:  &IssueChangelogAuthor{AccountID: "account-id-1", DisplayName: "Carlos"},
+			Created: "2024-01-05T09:00:00.000+0000",
+			Items: []*IssueChangelogHistoryItemScheme{
+				{Field: "status", FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"},
+				{Field: "assignee", FieldID: "assignee", To: "account-id-1", ToString: "Carlos"},
+				{Field: "Component", FieldID: "components", To: "10002", ToString: "API"},
+			},
+		},
+	}
+}
+
+func TestBuildIssueTimeline(t *testing.T) {
+
+	timeline, err := BuildIssueTimeline(issueHistoryMocked())
+	assert.NoError(t, err)
+	assert.Len(t, timeline, 5)
+
+	assert.Equal(t, &IssueTimelineEntryScheme{
+		At:         time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC),
+		HistoryID:  "10001",
+		AccountID:  "account-id-1",
+		Author:     "Carlos",
+		FieldID:    "status",
+		Field:      "status",
+		From:       "1",
+		FromString: "To Do",
+		To:         "3",
+		ToString:   "In Progress",
+	}, normalizeIssueTimelineEntry(timeline[0]))
+	assert.Equal(t, "10002", timeline[4].HistoryID)
+
+	buffer := new(bytes.Buffer)
+	assert.NoError(t, WriteIssueTimelineCSV(buffer, timeline[:1]))
+	assert.Equal(t, "at,historyId,accountId,author,fieldId,field,from,fromString,to,toString\n"+
+		"2024-01-05T09:00:00Z,10001,account-id-1,Carlos,status,status,1,To Do,3,In Progress\n", buffer.String())
+
+	_, err = BuildIssueTimeline([]*IssueChangelogHistoryScheme{{ID: "1", Created: "yesterday"}})
+	assert.Error(t, err)
+}
+
+func normalizeIssueTimelineEntry(entry *IssueTimelineEntryScheme) *IssueTimelineEntryScheme {
+	entry.At = entry.At.UTC()
+	return entry
+}
+
+func TestReconstructIssue(t *testing.T) {
+
+	created := DateTimeScheme(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+
+	issueMocked := &IssueScheme{
+		Key: "KP-1",
+		Fields: &IssueFieldsScheme{
+			Summary:    "Summary",
+			Status:     &StatusScheme{ID: "10001", Name: "Done"},
+			Assignee:   &UserScheme{AccountID: "account-id-1", DisplayName: "Carlos"},
+			Components: []*ComponentScheme{{ID: "10002", Name: "API"}},
+			Created:    &created,
+		},
+		Changelog: &IssueChangelogScheme{Histories: issueHistoryMocked()},
+	}
+
+	testCases := []struct {
+		name           string
+		at             time.Time
+		wantStatus     *IssueFieldValueScheme
+		wantAssignee   *IssueFieldValueScheme
+		wantComponents []*IssueFieldValueScheme
+		wantErr        bool
+		Err            error
+	}{
+		{
+			name:           "when the timestamp is after the last change",
+			at:             time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantStatus:     &IssueFieldValueScheme{ID: "10001", String: "Done"},
+			wantAssignee:   &IssueFieldValueScheme{ID: "account-id-1", String: "Carlos"},
+			wantComponents: []*IssueFieldValueScheme{{ID: "10002", String: "API"}},
+		},
+		{
+			name:           "when the timestamp is between the changes",
+			at:             time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantStatus:     &IssueFieldValueScheme{ID: "3", String: "In Progress"},
+			wantAssignee:   &IssueFieldValueScheme{ID: "account-id-1", String: "Carlos"},
+			wantComponents: []*IssueFieldValueScheme{{ID: "10002", String: "API"}, {ID: "10001", String: "UI"}},
+		},
+		{
+			name:           "when the timestamp is before the first change",
+			at:             time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantStatus:     &IssueFieldValueScheme{ID: "1", String: "To Do"},
+			wantComponents: []*IssueFieldValueScheme{{ID: "10001", String: "UI"}},
+		},
+		{
+			name:    "when the timestamp is before the issue creation",
+			at:      time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: true,
+			Err:     ErrIssueNotCreated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ReconstructIssue(issueMocked, nil, testCase.at)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, got.Fields["status"].Value)
+			assert.Equal(t, testCase.wantAssignee, got.Fields["assignee"].Value)
+			assert.Equal(t, testCase.wantComponents, got.Fields["components"].Values)
+			assert.Equal(t, &IssueFieldValueScheme{String: "Summary"}, got.Fields["summary"].Value)
+			assert.Equal(t, "Summary", got.Fields["summary"].Raw)
+		})
+	}
+
+	_, err := ReconstructIssue(nil, nil, time.Now())
+	assert.ErrorIs(t, err, ErrNoIssueScheme)
+}
@@ -0,0 +1,383 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// IssueFieldTag is the struct tag used to map Go struct fields into Jira issue fields.
+//
+// The tag value is the Jira field ID (e.g. "summary", "customfield_10020") or the field
+// name prefixed with "name=" (e.g. "name=Story Points"), followed by optional comma separated
+// options:
+//
+//   - omitempty: the field is skipped when marshaling a zero value.
+//   - date: time.Time values are formatted as Jira dates (2006-01-02) instead of date-times.
+//
+// A tag value of "-" ignores the struct field.
+const IssueFieldTag = "jira"
+
+// IssueFieldMapper maps Go structs annotated with the IssueFieldTag tag into Jira issue fields and back.
+//
+// The mapper resolves the "name=" tags using the field catalog returned by the Issue.Field.Gets method.
+type IssueFieldMapper struct {
+	names map[string]string
+}
+
+// NewIssueFieldMapper creates a new IssueFieldMapper.
+// The fields parameter is used to resolve the field names into field IDs, it can be nil
+// when the struct tags only use field IDs.
+func NewIssueFieldMapper(fields []*IssueFieldScheme) *IssueFieldMapper {
+
+	names := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if field == nil || field.Name == "" {
+			continue
+		}
+
+		names[field.Name] = field.ID
+	}
+
+	return &IssueFieldMapper{names: names}
+}
+
+// MarshalIssueFields marshals a tagged struct into a CustomFields collection using field IDs only.
+func MarshalIssueFields(v interface{}) (*CustomFields, error) {
+	return NewIssueFieldMapper(nil).Marshal(v)
+}
+
+// UnmarshalIssueFields unmarshals an issue response buffer into a tagged struct using field IDs only.
+func UnmarshalIssueFields(buffer bytes.Buffer, v interface{}) error {
+	return NewIssueFieldMapper(nil).Unmarshal(buffer, v)
+}
+
+// Marshal converts a tagged struct (or a pointer to one) into a CustomFields collection.
+//
+// The returned collection can be sent to the issue Create, Creates, Update and Move methods,
+// where it's merged into the issue payload using IssueScheme.MergeCustomFields.
+//
+// The values are encoded following the Go type of each struct field:
+//
+//   - string, numbers, bool and []string are sent as is.
+//   - time.Time is sent using the TimeFormat layout, or as a date when the "date" option is set.
+//   - *UserScheme and *UserDetailScheme are sent as {"accountId": ...}.
+//   - *GroupDetailScheme is sent as {"name": ...}.
+//   - *CustomFieldContextOptionScheme is sent as {"value": ...} or {"id": ...}.
+//   - *CascadingSelectScheme is sent as {"value": ..., "child": {"value": ...}}.
+//   - *VersionScheme and *VersionDetailScheme are sent as {"id": ...} or {"name": ...}.
+//   - *SprintDetailScheme is sent as the sprint ID, slices use the last sprint.
+//   - *DateScheme and *DateTimeScheme are sent using the DateFormat and TimeFormat layouts.
+//
+// Slices of the types above are sent as arrays, any other type is sent using its JSON encoding.
+//
+// The nil pointers and interfaces, and the nil or empty slices and maps, are sent as null to clear the field.
+// The other zero values, e.g. 0 or false, are sent as they are unless the omitempty option is set.
+func (m *IssueFieldMapper) Marshal(v interface{}) (*CustomFields, error) {
+
+	value, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	customFields := &CustomFields{}
+	for index := 0; index < value.NumField(); index++ {
+
+		field := value.Type().Field(index)
+
+		tag, ok, err := m.parseTag(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		fieldValue := value.Field(index)
+		if tag.omitEmpty && (fieldValue.IsZero() || isEmptyIssueField(fieldValue)) {
+			continue
+		}
+
+		if isEmptyIssueField(fieldValue) {
+
+			var valueNode = map[string]interface{}{}
+			valueNode[tag.id] = nil
+
+			customFields.Fields = append(customFields.Fields, map[string]interface{}{"fields": valueNode})
+			continue
+		}
+
+		if err := customFields.Raw(tag.id, encodeIssueField(fieldValue, tag)); err != nil {
+			return nil, err
+		}
+	}
+
+	return customFields, nil
+}
+
+// Unmarshal populates a tagged struct pointer with the field values of an issue response.
+//
+// The buffer must contain a single issue, such as the ResponseScheme.Bytes returned by the
+// Issue.Get method of the v2 and v3 clients. Fields missing from the response, or set to null,
+// keep their zero value.
+func (m *IssueFieldMapper) Unmarshal(buffer bytes.Buffer, v interface{}) error {
+
+	pointer := reflect.ValueOf(v)
+	if pointer.Kind() != reflect.Ptr || pointer.IsNil() {
+		return ErrNoIssueFieldsStruct
+	}
+
+	value, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	raw := gjson.ParseBytes(buffer.Bytes())
+	if !raw.Get("fields").Exists() {
+		return ErrNoFieldInformation
+	}
+
+	for index := 0; index < value.NumField(); index++ {
+
+		tag, ok, err := m.parseTag(value.Type().Field(index))
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		result := raw.Get("fields").Get(gjson.Escape(tag.id))
+		if !result.Exists() || result.Type == gjson.Null {
+			continue
+		}
+
+		if err := decodeIssueField(result, value.Field(index)); err != nil {
+			return fmt.Errorf("jira: unable to decode the field %v: %w", tag.id, err)
+		}
+	}
+
+	return nil
+}
+
+type issueFieldTag struct {
+	id        string
+	omitEmpty bool
+	date      bool
+}
+
+func (m *IssueFieldMapper) parseTag(field reflect.StructField) (*issueFieldTag, bool, error) {
+
+	value, ok := field.Tag.Lookup(IssueFieldTag)
+	if !ok || value == "-" || !field.IsExported() {
+		return nil, false, nil
+	}
+
+	parts := strings.Split(value, ",")
+	tag := &issueFieldTag{id: strings.TrimSpace(parts[0])}
+
+	for _, option := range parts[1:] {
+		switch strings.TrimSpace(option) {
+		case "omitempty":
+			tag.omitEmpty = true
+		case "date":
+			tag.date = true
+		}
+	}
+
+	if name, isName := strings.CutPrefix(tag.id, "name="); isName {
+
+		id, found := m.names[name]
+		if !found {
+			return nil, false, fmt.Errorf("%w: %v", ErrNoFieldNameMapping, name)
+		}
+
+		tag.id = id
+	}
+
+	if tag.id == "" {
+		return nil, false, ErrNoFieldID
+	}
+
+	return tag, true, nil
+}
+
+// isEmptyIssueField reports whether the value is sent as null, that is, a nil pointer or interface,
+// or a nil or empty slice or map.
+func isEmptyIssueField(value reflect.Value) bool {
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	}
+
+	return false
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr {
+
+		if value.IsNil() {
+			return reflect.Value{}, ErrNoIssueFieldsStruct
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, ErrNoIssueFieldsStruct
+	}
+
+	return value, nil
+}
+
+func encodeIssueField(value reflect.Value, tag *issueFieldTag) interface{} {
+
+	switch typed := value.Interface().(type) {
+	case time.Time:
+		if tag.date {
+			return typed.Format(DateFormat)
+		}
+		return typed.Format(TimeFormat)
+
+	case *DateScheme:
+		return time.Time(*typed).Format(DateFormat)
+
+	case *DateTimeScheme:
+		return time.Time(*typed).Format(TimeFormat)
+
+	case *UserScheme:
+		return map[string]interface{}{"accountId": typed.AccountID}
+
+	case *UserDetailScheme:
+		return map[string]interface{}{"accountId": typed.AccountID}
+
+	case *GroupDetailScheme:
+		return map[string]interface{}{"name": typed.Name}
+
+	case *CustomFieldContextOptionScheme:
+		if typed.Value == "" {
+			return map[string]interface{}{"id": typed.ID}
+		}
+		return map[string]interface{}{"value": typed.Value}
+
+	case *CascadingSelectScheme:
+		parentNode := map[string]interface{}{"value": typed.Value}
+		if typed.Child != nil {
+			parentNode["child"] = map[string]interface{}{"value": typed.Child.Value}
+		}
+		return parentNode
+
+	case *VersionScheme:
+		return versionNode(typed.ID, typed.Name)
+
+	case *VersionDetailScheme:
+		return versionNode(typed.ID, typed.Name)
+
+	case *SprintDetailScheme:
+		return typed.ID
+
+	case []*SprintDetailScheme:
+		for index := len(typed) - 1; index >= 0; index-- {
+			if typed[index] != nil {
+				return typed[index].ID
+			}
+		}
+	}
+
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Ptr {
+
+		nodes := make([]interface{}, 0, value.Len())
+		for index := 0; index < value.Len(); index++ {
+
+			if value.Index(index).IsNil() {
+				continue
+			}
+
+			nodes = append(nodes, encodeIssueField(value.Index(index), tag))
+		}
+
+		return nodes
+	}
+
+	return value.Interface()
+}
+
+func versionNode(id, name string) map[string]interface{} {
+
+	if id == "" {
+		return map[string]interface{}{"name": name}
+	}
+
+	return map[string]interface{}{"id": id}
+}
+
+func decodeIssueField(result gjson.Result, value reflect.Value) error {
+
+	switch value.Interface().(type) {
+	case time.Time:
+		parsed, err := parseIssueTime(result.String())
+		if err != nil {
+			return err
+		}
+
+		value.Set(reflect.ValueOf(parsed))
+		return nil
+
+	case *DateTimeScheme:
+		parsed, err := parseIssueTime(result.String())
+		if err != nil {
+			return err
+		}
+
+		dateTime := DateTimeScheme(parsed)
+		value.Set(reflect.ValueOf(&dateTime))
+		return nil
+
+	case *SprintDetailScheme:
+		// The sprint field is an array, the last element is the sprint the issue belongs to.
+		if result.IsArray() {
+			sprints := result.Array()
+			if len(sprints) == 0 {
+				return nil
+			}
+
+			result = sprints[len(sprints)-1]
+		}
+	}
+
+	target := reflect.New(value.Type())
+	if err := json.Unmarshal([]byte(result.Raw), target.Interface()); err != nil {
+		return err
+	}
+
+	value.Set(target.Elem())
+	return nil
+}
+
+func parseIssueTime(value string) (time.Time, error) {
+
+	layouts := []string{"2006-01-02T15:04:05.000-0700", TimeFormat, time.RFC3339, DateFormat}
+
+	var err error
+	for _, layout := range layouts {
+
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, err
+}
@@ -0,0 +1,262 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type issueMapperSample struct {
+	Summary     string                          `jira:"summary"`
+	Labels      []string                        `jira:"labels,omitempty"`
+	Points      float64                         `jira:"name=Story Points,omitempty"`
+	Team        string                          `jira:"customfield_10001,omitempty"`
+	Reviewer    *UserDetailScheme               `jira:"customfield_10002,omitempty"`
+	Severity    *CustomFieldContextOptionScheme `jira:"customfield_10003,omitempty"`
+	Region      *CascadingSelectScheme          `jira:"customfield_10004,omitempty"`
+	Sprint      *SprintDetailScheme             `jira:"customfield_10020,omitempty"`
+	Versions    []*VersionDetailScheme          `jira:"customfield_10005,omitempty"`
+	Due         time.Time                       `jira:"duedate,date,omitempty"`
+	Deployed    time.Time                       `jira:"customfield_10006,omitempty"`
+	Ignored     string                          `jira:"-"`
+	NotMapped   string
+	notExported string `jira:"customfield_10007"`
+}
+
+func TestIssueFieldMapper_Marshal(t *testing.T) {
+
+	fieldsMocked := []*IssueFieldScheme{{ID: "customfield_10010", Name: "Story Points"}}
+
+	sampleMocked := &issueMapperSample{
+		Summary:  "New summary",
+		Labels:   []string{"backend"},
+		Points:   5,
+		Reviewer: &UserDetailScheme{AccountID: "account-id"},
+		Severity: &CustomFieldContextOptionScheme{Value: "High"},
+		Region: &CascadingSelectScheme{
+			Value: "America",
+			Child: &CascadingSelectChildScheme{Value: "US"},
+		},
+		Sprint:   &SprintDetailScheme{ID: 4},
+		Versions: []*VersionDetailScheme{{ID: "10000"}, {Name: "v2.0"}},
+		Due:      time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Ignored:  "ignored",
+	}
+
+	expected := &CustomFields{Fields: []map[string]interface{}{
+		{"fields": map[string]interface{}{"summary": "New summary"}},
+		{"fields": map[string]interface{}{"labels": []string{"backend"}}},
+		{"fields": map[string]interface{}{"customfield_10010": float64(5)}},
+		{"fields": map[string]interface{}{"customfield_10002": map[string]interface{}{"accountId": "account-id"}}},
+		{"fields": map[string]interface{}{"customfield_10003": map[string]interface{}{"value": "High"}}},
+		{"fields": map[string]interface{}{"customfield_10004": map[string]interface{}{
+			"value": "America",
+			"child": map[string]interface{}{"value": "US"},
+		}}},
+		{"fields": map[string]interface{}{"customfield_10020": 4}},
+		{"fields": map[string]interface{}{"customfield_10005": []interface{}{
+			map[string]interface{}{"id": "10000"},
+			map[string]interface{}{"name": "v2.0"},
+		}}},
+		{"fields": map[string]interface{}{"duedate": "2024-01-02"}},
+	}}
+
+	testCases := []struct {
+		name    string
+		fields  []*IssueFieldScheme
+		v       interface{}
+		want    *CustomFields
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the struct is correctly tagged",
+			fields: fieldsMocked,
+			v:      sampleMocked,
+			want:   expected,
+		},
+
+		{
+			name:   "when the zero values are not omitted",
+			fields: fieldsMocked,
+			v: &struct {
+				Summary  string            `jira:"summary"`
+				Points   float64           `jira:"customfield_10010"`
+				Flagged  bool              `jira:"customfield_10011"`
+				Reviewer *UserDetailScheme `jira:"customfield_10002"`
+				Labels   []string          `jira:"labels"`
+				Context  map[string]string `jira:"customfield_10012"`
+				Value    interface{}       `jira:"customfield_10013"`
+			}{Labels: []string{}},
+			want: &CustomFields{Fields: []map[string]interface{}{
+				{"fields": map[string]interface{}{"summary": ""}},
+				{"fields": map[string]interface{}{"customfield_10010": float64(0)}},
+				{"fields": map[string]interface{}{"customfield_10011": false}},
+				{"fields": map[string]interface{}{"customfield_10002": nil}},
+				{"fields": map[string]interface{}{"labels": nil}},
+				{"fields": map[string]interface{}{"customfield_10012": nil}},
+				{"fields": map[string]interface{}{"customfield_10013": nil}},
+			}},
+		},
+
+		{
+			name:   "when the date-time is formatted",
+			fields: fieldsMocked,
+			v: &struct {
+				Deployed time.Time `jira:"customfield_10006"`
+			}{Deployed: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+			want: &CustomFields{Fields: []map[string]interface{}{
+				{"fields": map[string]interface{}{"customfield_10006": "2024-01-02T15:04:05+0000"}},
+			}},
+		},
+
+		{
+			name:    "when the field name cannot be resolved",
+			fields:  nil,
+			v:       sampleMocked,
+			wantErr: true,
+			Err:     ErrNoFieldNameMapping,
+		},
+
+		{
+			name:    "when the value is not a struct",
+			v:       "summary",
+			wantErr: true,
+			Err:     ErrNoIssueFieldsStruct,
+		},
+
+		{
+			name: "when the field id is not provided",
+			v: &struct {
+				Summary string `jira:",omitempty"`
+			}{},
+			wantErr: true,
+			Err:     ErrNoFieldID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := NewIssueFieldMapper(testCase.fields).Marshal(testCase.v)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, testCase.Err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestIssueFieldMapper_Unmarshal(t *testing.T) {
+
+	bufferMocked := bytes.Buffer{}
+	bufferMocked.WriteString(`
+{
+	"key": "KP-1",
+	"fields": {
+		"summary": "Issue summary",
+		"labels": ["backend", "api"],
+		"customfield_10010": 8,
+		"customfield_10001": null,
+		"customfield_10002": {"accountId": "account-id", "displayName": "Carlos"},
+		"customfield_10003": {"id": "10040", "value": "High"},
+		"customfield_10004": {"value": "America", "child": {"value": "US"}},
+		"customfield_10020": [{"id": 3, "name": "Sprint 3"}, {"id": 4, "name": "Sprint 4"}],
+		"customfield_10005": [{"id": "10000", "name": "v1.0"}],
+		"duedate": "2024-01-02",
+		"customfield_10006": "2024-01-02T10:30:00.000+0000"
+	}
+}`)
+
+	bufferMockedWithNoFields := bytes.Buffer{}
+	bufferMockedWithNoFields.WriteString(`{"key": "KP-1"}`)
+
+	bufferMockedWithInvalidType := bytes.Buffer{}
+	bufferMockedWithInvalidType.WriteString(`{"fields": {"summary": {"type": "doc"}}}`)
+
+	testCases := []struct {
+		name    string
+		fields  []*IssueFieldScheme
+		buffer  bytes.Buffer
+		v       interface{}
+		want    interface{}
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the buffer contains the issue fields",
+			fields: []*IssueFieldScheme{{ID: "customfield_10010", Name: "Story Points"}},
+			buffer: bufferMocked,
+			v:      &issueMapperSample{},
+			want: &issueMapperSample{
+				Summary:  "Issue summary",
+				Labels:   []string{"backend", "api"},
+				Points:   8,
+				Reviewer: &UserDetailScheme{AccountID: "account-id", DisplayName: "Carlos"},
+				Severity: &CustomFieldContextOptionScheme{ID: "10040", Value: "High"},
+				Region: &CascadingSelectScheme{
+					Value: "America",
+					Child: &CascadingSelectChildScheme{Value: "US"},
+				},
+				Sprint:   &SprintDetailScheme{ID: 4, Name: "Sprint 4"},
+				Versions: []*VersionDetailScheme{{ID: "10000", Name: "v1.0"}},
+				Due:      time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+
+		{
+			name:    "when the buffer does not contain the fields object",
+			buffer:  bufferMockedWithNoFields,
+			v:       &issueMapperSample{},
+			wantErr: true,
+			Err:     ErrNoFieldInformation,
+		},
+
+		{
+			name:    "when the value is not a pointer",
+			buffer:  bufferMocked,
+			v:       issueMapperSample{},
+			wantErr: true,
+			Err:     ErrNoIssueFieldsStruct,
+		},
+
+		{
+			name:   "when the field type does not match the response",
+			buffer: bufferMockedWithInvalidType,
+			v: &struct {
+				Summary string `jira:"summary"`
+			}{},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := NewIssueFieldMapper(testCase.fields).Unmarshal(testCase.buffer, testCase.v)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+
+				if testCase.Err != nil {
+					assert.ErrorIs(t, err, testCase.Err)
+				}
+			} else {
+				assert.NoError(t, err)
+
+				// The date-time fields are parsed on the local time zone, compare the instant only
+				if sample, ok := testCase.v.(*issueMapperSample); ok {
+					assert.True(t, time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC).Equal(sample.Deployed))
+					sample.Deployed = time.Time{}
+				}
+
+				assert.Equal(t, testCase.want, testCase.v)
+			}
+		})
+	}
+}
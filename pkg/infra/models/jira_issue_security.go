@@ -7,8 +7,187 @@ type IssueSecurityLevelsScheme struct {
 
 // IssueSecurityLevelScheme represents a security level of an issue in Jira.
 type IssueSecurityLevelScheme struct {
-	Self        string `json:"self,omitempty"`        // The URL of the security level.
-	ID          string `json:"id,omitempty"`          // The ID of the security level.
-	Description string `json:"description,omitempty"` // The description of the security level.
-	Name        string `json:"name,omitempty"`        // The name of the security level.
+	Self                  string `json:"self,omitempty"`                  // The URL of the security level.
+	ID                    string `json:"id,omitempty"`                    // The ID of the security level.
+	Description           string `json:"description,omitempty"`           // The description of the security level.
+	Name                  string `json:"name,omitempty"`                  // The name of the security level.
+	IsDefault             bool   `json:"isDefault,omitempty"`             // Indicates if the security level is the default level of its scheme.
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"` // The ID of the issue security scheme of the level.
+}
+
+// IssueSecuritySchemesScheme represents the issue security schemes in Jira.
+type IssueSecuritySchemesScheme struct {
+	IssueSecuritySchemes []*IssueSecuritySchemeScheme `json:"issueSecuritySchemes,omitempty"` // The issue security schemes.
+}
+
+// IssueSecuritySchemeScheme represents an issue security scheme in Jira.
+type IssueSecuritySchemeScheme struct {
+	Self                   string                      `json:"self,omitempty"`                   // The URL of the issue security scheme.
+	ID                     int                         `json:"id,omitempty"`                     // The ID of the issue security scheme.
+	Name                   string                      `json:"name,omitempty"`                   // The name of the issue security scheme.
+	Description            string                      `json:"description,omitempty"`            // The description of the issue security scheme.
+	DefaultSecurityLevelID int                         `json:"defaultSecurityLevelId,omitempty"` // The ID of the default security level.
+	Levels                 []*IssueSecurityLevelScheme `json:"levels,omitempty"`                 // The security levels of the scheme.
+}
+
+// IssueSecuritySchemePageScheme represents a page of issue security schemes in Jira.
+type IssueSecuritySchemePageScheme struct {
+	Self       string                               `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                               `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                                  `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                                  `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                                  `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                                 `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueSecuritySchemeProjectsScheme `json:"values,omitempty"`     // The issue security schemes in the page.
+}
+
+// IssueSecuritySchemeProjectsScheme represents an issue security scheme and the projects using it in Jira.
+type IssueSecuritySchemeProjectsScheme struct {
+	Self         string `json:"self,omitempty"`         // The URL of the issue security scheme.
+	ID           int    `json:"id,omitempty"`           // The ID of the issue security scheme.
+	Name         string `json:"name,omitempty"`         // The name of the issue security scheme.
+	Description  string `json:"description,omitempty"`  // The description of the issue security scheme.
+	DefaultLevel int    `json:"defaultLevel,omitempty"` // The ID of the default security level.
+	ProjectIDs   []int  `json:"projectIds,omitempty"`   // The IDs of the projects using the scheme.
+}
+
+// IssueSecuritySchemeSearchOptions represents the options used to search issue security schemes in Jira.
+type IssueSecuritySchemeSearchOptions struct {
+	IDs        []string // The IDs of the issue security schemes.
+	ProjectIDs []string // The IDs of the projects using the schemes.
+}
+
+// IssueSecuritySchemePayloadScheme represents the payload used to create an issue security scheme in Jira.
+type IssueSecuritySchemePayloadScheme struct {
+	Name        string                             `json:"name,omitempty"`        // The name of the issue security scheme.
+	Description string                             `json:"description,omitempty"` // The description of the issue security scheme.
+	Levels      []*IssueSecurityLevelPayloadScheme `json:"levels,omitempty"`      // The security levels of the scheme.
+}
+
+// IssueSecurityUpdatePayloadScheme represents the payload used to update an issue security scheme or security level in Jira.
+type IssueSecurityUpdatePayloadScheme struct {
+	Name        string `json:"name,omitempty"`        // The name of the scheme or level.
+	Description string `json:"description,omitempty"` // The description of the scheme or level.
+}
+
+// IssueSecurityLevelPayloadScheme represents the payload of a security level in Jira.
+type IssueSecurityLevelPayloadScheme struct {
+	Name        string                                   `json:"name,omitempty"`        // The name of the security level.
+	Description string                                   `json:"description,omitempty"` // The description of the security level.
+	IsDefault   bool                                     `json:"isDefault,omitempty"`   // Indicates if the security level is the default level of the scheme.
+	Members     []*IssueSecurityLevelMemberPayloadScheme `json:"members,omitempty"`     // The members of the security level.
+}
+
+// IssueSecurityLevelMemberPayloadScheme represents the payload of a security level member in Jira.
+type IssueSecurityLevelMemberPayloadScheme struct {
+	Type      string `json:"type,omitempty"`      // The type of the member, e.g. "group", "user", "projectRole" or "reporter".
+	Parameter string `json:"parameter,omitempty"` // The value of the member, e.g. the group ID or the account ID.
+}
+
+// IssueSecuritySchemeCreatedScheme represents the issue security scheme created in Jira.
+type IssueSecuritySchemeCreatedScheme struct {
+	ID string `json:"id,omitempty"` // The ID of the issue security scheme.
+}
+
+// IssueSecurityLevelPageScheme represents a page of security levels in Jira.
+type IssueSecurityLevelPageScheme struct {
+	Self       string                      `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                      `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                         `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                         `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                         `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                        `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueSecurityLevelScheme `json:"values,omitempty"`     // The security levels in the page.
+}
+
+// IssueSecurityLevelSearchOptions represents the options used to search security levels in Jira.
+type IssueSecurityLevelSearchOptions struct {
+	IDs         []string // The IDs of the security levels.
+	SchemeIDs   []string // The IDs of the issue security schemes of the levels.
+	OnlyDefault bool     // Returns only the default levels of the schemes.
+}
+
+// IssueSecurityLevelsPayloadScheme represents the payload used to add security levels to a scheme in Jira.
+type IssueSecurityLevelsPayloadScheme struct {
+	Levels []*IssueSecurityLevelPayloadScheme `json:"levels,omitempty"` // The security levels to add.
+}
+
+// IssueSecurityLevelDefaultsPayloadScheme represents the payload used to set the default security levels in Jira.
+type IssueSecurityLevelDefaultsPayloadScheme struct {
+	DefaultValues []*IssueSecurityLevelDefaultScheme `json:"defaultValues,omitempty"` // The default levels, per scheme.
+}
+
+// IssueSecurityLevelDefaultScheme represents the default security level of an issue security scheme in Jira.
+type IssueSecurityLevelDefaultScheme struct {
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"` // The ID of the issue security scheme.
+	DefaultLevelID        string `json:"defaultLevelId,omitempty"`        // The ID of the default security level.
+}
+
+// IssueSecurityLevelMemberPageScheme represents a page of security level members in Jira.
+type IssueSecurityLevelMemberPageScheme struct {
+	Self       string                            `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                            `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                               `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                               `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                               `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                              `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueSecurityLevelMemberScheme `json:"values,omitempty"`     // The security level members in the page.
+}
+
+// IssueSecurityLevelMemberScheme represents a member of a security level in Jira.
+type IssueSecurityLevelMemberScheme struct {
+	ID                    string                       `json:"id,omitempty"`                    // The ID of the member.
+	IssueSecurityLevelID  string                       `json:"issueSecurityLevelId,omitempty"`  // The ID of the security level.
+	IssueSecuritySchemeID string                       `json:"issueSecuritySchemeId,omitempty"` // The ID of the issue security scheme.
+	Holder                *PermissionGrantHolderScheme `json:"holder,omitempty"`                // The user, group or role of the member.
+	ManagedBy             string                       `json:"managedBy,omitempty"`             // The entity managing the member.
+}
+
+// IssueSecurityLevelMemberSearchOptions represents the options used to search security level members in Jira.
+type IssueSecurityLevelMemberSearchOptions struct {
+	IDs       []string // The IDs of the members.
+	SchemeIDs []string // The IDs of the issue security schemes.
+	LevelIDs  []string // The IDs of the security levels.
+	Expand    []string // The expand options, e.g. "all", "field", "group", "projectRole" or "user".
+}
+
+// IssueSecurityLevelMembersPayloadScheme represents the payload used to add members to a security level in Jira.
+type IssueSecurityLevelMembersPayloadScheme struct {
+	Members []*IssueSecurityLevelMemberPayloadScheme `json:"members,omitempty"` // The members to add.
+}
+
+// IssueSecuritySchemeAssociationPageScheme represents a page of issue security scheme and project associations in Jira.
+type IssueSecuritySchemeAssociationPageScheme struct {
+	Self       string                                  `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                                  `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                                     `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                                     `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                                     `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                                    `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueSecuritySchemeAssociationScheme `json:"values,omitempty"`     // The associations in the page.
+}
+
+// IssueSecuritySchemeAssociationScheme represents the association of an issue security scheme with a project in Jira.
+type IssueSecuritySchemeAssociationScheme struct {
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty"` // The ID of the issue security scheme.
+	ProjectID             string `json:"projectId,omitempty"`             // The ID of the project.
+}
+
+// IssueSecuritySchemeAssociationSearchOptions represents the options used to search the associations of issue security schemes in Jira.
+type IssueSecuritySchemeAssociationSearchOptions struct {
+	SchemeIDs  []string // The IDs of the issue security schemes.
+	ProjectIDs []string // The IDs of the projects.
+}
+
+// IssueSecuritySchemeAssociatePayloadScheme represents the payload used to associate an issue security scheme with a project in Jira.
+type IssueSecuritySchemeAssociatePayloadScheme struct {
+	ProjectID                     string                             `json:"projectId,omitempty"`                     // The ID of the project.
+	SchemeID                      string                             `json:"schemeId,omitempty"`                      // The ID of the issue security scheme, "-1" to remove the scheme.
+	OldToNewSecurityLevelMappings []*IssueSecurityLevelMappingScheme `json:"oldToNewSecurityLevelMappings,omitempty"` // The mappings of the levels used by the issues of the project.
+}
+
+// IssueSecurityLevelMappingScheme represents the mapping of a security level to the level of another scheme in Jira.
+type IssueSecurityLevelMappingScheme struct {
+	OldLevelID string `json:"oldLevelId,omitempty"` // The ID of the current security level, "-1" for the issues without level.
+	NewLevelID string `json:"newLevelId,omitempty"` // The ID of the new security level, "-1" to remove the level.
 }
@@ -0,0 +1,55 @@
+package models
+
+import "context"
+
+// IssueTransitionFieldScheme represents a field of a transition screen in Jira.
+type IssueTransitionFieldScheme struct {
+	Required        bool                    `json:"required,omitempty"`        // Indicates if the field is required.
+	Schema          *IssueFieldSchemaScheme `json:"schema,omitempty"`          // The schema of the field.
+	Name            string                  `json:"name,omitempty"`            // The name of the field.
+	Key             string                  `json:"key,omitempty"`             // The key of the field.
+	HasDefaultValue bool                    `json:"hasDefaultValue,omitempty"` // Indicates if the field has a default value.
+	Operations      []string                `json:"operations,omitempty"`      // The operations supported by the field.
+	AllowedValues   []interface{}           `json:"allowedValues,omitempty"`   // The values allowed on the field.
+}
+
+// IssueTransitionPathScheme represents the transitions performed to move an issue to a status in Jira.
+type IssueTransitionPathScheme struct {
+	From  *StatusScheme            `json:"from,omitempty"`  // The status of the issue before the transitions.
+	To    *StatusScheme            `json:"to,omitempty"`    // The status of the issue after the transitions.
+	Steps []*IssueTransitionScheme `json:"steps,omitempty"` // The transitions performed, in order.
+}
+
+// IssueTransitionToOptionsV3 represents the options used to move an issue to a status, using the v3 issue scheme.
+type IssueTransitionToOptionsV3 struct {
+	// Fields returns the fields sent on a transition with a screen, it can return nil when no fields are required.
+	// The transition includes the screen fields, use the Required flag to find the fields that must be set.
+	Fields func(ctx context.Context, issueKeyOrID string, transition *IssueTransitionScheme) (*IssueMoveOptionsV3, error)
+	// MaxSteps is the maximum number of transitions performed, 10 when zero.
+	MaxSteps int
+}
+
+// IssueTransitionToOptionsV2 represents the options used to move an issue to a status, using the v2 issue scheme.
+type IssueTransitionToOptionsV2 struct {
+	// Fields returns the fields sent on a transition with a screen, it can return nil when no fields are required.
+	// The transition includes the screen fields, use the Required flag to find the fields that must be set.
+	Fields func(ctx context.Context, issueKeyOrID string, transition *IssueTransitionScheme) (*IssueMoveOptionsV2, error)
+	// MaxSteps is the maximum number of transitions performed, 10 when zero.
+	MaxSteps int
+}
+
+// RequiresFields reports whether the transition has a screen or any required field without a default value.
+func (t *IssueTransitionScheme) RequiresFields() bool {
+
+	if t.HasScreen {
+		return true
+	}
+
+	for _, field := range t.Fields {
+		if field != nil && field.Required && !field.HasDefaultValue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -181,8 +181,9 @@ type IssueBulkResponseScheme struct {
 type IssueBulkResponseErrorScheme struct {
 	Status        int `json:"status"` // The status of the error.
 	ElementErrors struct {
-		ErrorMessages []string `json:"errorMessages"` // The error messages.
-		Status        int      `json:"status"`        // The status of the error messages.
+		ErrorMessages []string          `json:"errorMessages"`    // The error messages.
+		Errors        map[string]string `json:"errors,omitempty"` // The errors keyed by field.
+		Status        int               `json:"status"`           // The status of the error messages.
 	} `json:"elementErrors"` // The element errors in the response.
 	FailedElementNumber int `json:"failedElementNumber"` // The number of the failed element.
 }
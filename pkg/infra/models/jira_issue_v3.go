@@ -138,15 +138,16 @@ type IssueFieldsScheme struct {
 
 // IssueTransitionScheme represents a transition of an issue in Jira.
 type IssueTransitionScheme struct {
-	ID            string        `json:"id,omitempty"`            // The ID of the transition.
-	Name          string        `json:"name,omitempty"`          // The name of the transition.
-	To            *StatusScheme `json:"to,omitempty"`            // The status the issue transitions to.
-	HasScreen     bool          `json:"hasScreen,omitempty"`     // Indicates if the transition has a screen.
-	IsGlobal      bool          `json:"isGlobal,omitempty"`      // Indicates if the transition is global.
-	IsInitial     bool          `json:"isInitial,omitempty"`     // Indicates if the transition is initial.
-	IsAvailable   bool          `json:"isAvailable,omitempty"`   // Indicates if the transition is available.
-	IsConditional bool          `json:"isConditional,omitempty"` // Indicates if the transition is conditional.
-	IsLooped      bool          `json:"isLooped,omitempty"`      // Indicates if the transition is looped.
+	ID            string                                 `json:"id,omitempty"`            // The ID of the transition.
+	Name          string                                 `json:"name,omitempty"`          // The name of the transition.
+	To            *StatusScheme                          `json:"to,omitempty"`            // The status the issue transitions to.
+	HasScreen     bool                                   `json:"hasScreen,omitempty"`     // Indicates if the transition has a screen.
+	IsGlobal      bool                                   `json:"isGlobal,omitempty"`      // Indicates if the transition is global.
+	IsInitial     bool                                   `json:"isInitial,omitempty"`     // Indicates if the transition is initial.
+	IsAvailable   bool                                   `json:"isAvailable,omitempty"`   // Indicates if the transition is available.
+	IsConditional bool                                   `json:"isConditional,omitempty"` // Indicates if the transition is conditional.
+	IsLooped      bool                                   `json:"isLooped,omitempty"`      // Indicates if the transition is looped.
+	Fields        map[string]*IssueTransitionFieldScheme `json:"fields,omitempty"`        // The fields of the transition screen, returned using the "transitions.fields" expand.
 }
 
 // StatusScheme represents the status of an issue in Jira.
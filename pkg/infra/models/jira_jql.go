@@ -8,20 +8,46 @@ type ParsedQueryPageScheme struct {
 
 // ParseQueryScheme represents a parsed query in Jira.
 type ParseQueryScheme struct {
-	Query     string `json:"query"` // The query.
-	Structure struct {
-		Where struct {
-		} `json:"where"` // The where clause of the query.
-		OrderBy *QueryStructureOrderScheme `json:"orderBy"` // The order by clause of the query.
-	} `json:"structure"` // The structure of the query.
-	Errors []string `json:"errors"` // The errors occurred during parsing the query.
+	Query     string               `json:"query"`     // The query.
+	Structure QueryStructureScheme `json:"structure"` // The structure of the query.
+	Errors    []string             `json:"errors"`    // The errors occurred during parsing the query.
 }
 
 // QueryStructureScheme represents the structure of a query in Jira.
 type QueryStructureScheme struct {
+	Where   *QueryClauseScheme         `json:"where"`   // The where clause of the query.
 	OrderBy *QueryStructureOrderScheme `json:"orderBy"` // The order by clause of the query.
 }
 
+// QueryClauseScheme represents a clause of the where section of a parsed query in Jira.
+// Compound clauses (and, or, not) contain the nested Clauses, the field clauses contain the Field,
+// the Operand and, for the history clauses (was, changed), the Predicates.
+type QueryClauseScheme struct {
+	Clauses    []*QueryClauseScheme                `json:"clauses,omitempty"`    // The nested clauses of a compound clause.
+	Field      *QueryStructureOrderFieldNodeScheme `json:"field,omitempty"`      // The field of the clause.
+	Operator   string                              `json:"operator,omitempty"`   // The operator of the clause.
+	Operand    *QueryOperandScheme                 `json:"operand,omitempty"`    // The operand of the clause.
+	Predicates []*QueryPredicateScheme             `json:"predicates,omitempty"` // The predicates of a history clause.
+}
+
+// QueryOperandScheme represents an operand of a clause in a parsed query in Jira.
+// The operand is a value, a list of values, a function or a keyword.
+type QueryOperandScheme struct {
+	Value          string                `json:"value,omitempty"`          // The value of the operand.
+	EncodedValue   string                `json:"encodedValue,omitempty"`   // The encoded value of the operand.
+	Values         []*QueryOperandScheme `json:"values,omitempty"`         // The values of a list operand.
+	Function       string                `json:"function,omitempty"`       // The name of a function operand.
+	Arguments      []string              `json:"arguments,omitempty"`      // The arguments of a function operand.
+	Keyword        string                `json:"keyword,omitempty"`        // The keyword of a keyword operand (EMPTY).
+	EncodedOperand string                `json:"encodedOperand,omitempty"` // The encoded operand.
+}
+
+// QueryPredicateScheme represents a predicate of a history clause in a parsed query in Jira.
+type QueryPredicateScheme struct {
+	Operator string              `json:"operator,omitempty"` // The operator of the predicate (after, before, by, during, on, from, to).
+	Operand  *QueryOperandScheme `json:"operand,omitempty"`  // The operand of the predicate.
+}
+
 // QueryStructureOrderScheme represents the order by clause of a query in Jira.
 type QueryStructureOrderScheme struct {
 	Fields []*QueryStructureOrderFieldScheme `json:"fields"` // The fields in the order by clause.
@@ -35,8 +61,9 @@ type QueryStructureOrderFieldScheme struct {
 
 // QueryStructureOrderFieldNodeScheme represents a field node in the order by clause of a query in Jira.
 type QueryStructureOrderFieldNodeScheme struct {
-	Name     string                 `json:"name"`     // The name of the field.
-	Property []*QueryPropertyScheme `json:"property"` // The properties of the field.
+	Name        string                 `json:"name"`                  // The name of the field.
+	EncodedName string                 `json:"encodedName,omitempty"` // The encoded name of the field.
+	Property    []*QueryPropertyScheme `json:"property"`              // The properties of the field.
 }
 
 // QueryPropertyScheme represents a property of a field in the order by clause of a query in Jira.
@@ -46,3 +73,100 @@ type QueryPropertyScheme struct {
 	Path   string `json:"path"`   // The path of the property.
 	Type   string `json:"type"`   // The type of the property.
 }
+
+// JQLReferenceDataScheme represents the fields, functions and reserved words available in the JQL queries in Jira.
+type JQLReferenceDataScheme struct {
+	VisibleFieldNames    []*JQLFieldReferenceScheme    `json:"visibleFieldNames,omitempty"`    // The fields usable in the queries.
+	VisibleFunctionNames []*JQLFunctionReferenceScheme `json:"visibleFunctionNames,omitempty"` // The functions usable in the queries.
+	JqlReservedWords     []string                      `json:"jqlReservedWords,omitempty"`     // The reserved words, they must be quoted when used as values.
+}
+
+// JQLFieldReferenceScheme represents a field usable in the JQL queries in Jira.
+type JQLFieldReferenceScheme struct {
+	Value                 string   `json:"value,omitempty"`                 // The name of the field, as used in the queries.
+	DisplayName           string   `json:"displayName,omitempty"`           // The display name of the field.
+	Orderable             string   `json:"orderable,omitempty"`             // Indicates if the field can be used in the ORDER BY clause, "true" or "false".
+	Searchable            string   `json:"searchable,omitempty"`            // Indicates if the field can be used in the where clause, "true" or "false".
+	Auto                  string   `json:"auto,omitempty"`                  // Indicates if the field values are suggested, "true" or "false".
+	CfID                  string   `json:"cfid,omitempty"`                  // The ID of the custom field, e.g. "cf[10000]".
+	Operators             []string `json:"operators,omitempty"`             // The operators usable with the field.
+	Types                 []string `json:"types,omitempty"`                 // The data types of the field.
+	Deprecated            string   `json:"deprecated,omitempty"`            // Indicates if the field is deprecated, "true" or "false".
+	DeprecatedSearcherKey string   `json:"deprecatedSearcherKey,omitempty"` // The searcher key of the deprecated field.
+}
+
+// JQLFunctionReferenceScheme represents a function usable in the JQL queries in Jira.
+type JQLFunctionReferenceScheme struct {
+	Value                               string   `json:"value,omitempty"`                               // The function, as used in the queries, e.g. "currentUser()".
+	DisplayName                         string   `json:"displayName,omitempty"`                         // The display name of the function.
+	IsList                              string   `json:"isList,omitempty"`                              // Indicates if the function returns a list, "true" or "false".
+	SupportsListAndSingleValueOperators string   `json:"supportsListAndSingleValueOperators,omitempty"` // Indicates if the function works with both list and single value operators, "true" or "false".
+	Types                               []string `json:"types,omitempty"`                               // The data types returned by the function.
+}
+
+// JQLReferenceDataPayloadScheme represents the filter of the JQL autocomplete data in Jira.
+type JQLReferenceDataPayloadScheme struct {
+	ProjectIDs             []int `json:"projectIds,omitempty"`             // The IDs of the projects, returns the fields of these projects only.
+	IncludeCollapsedFields bool  `json:"includeCollapsedFields,omitempty"` // Returns the fields with the same name as a single collapsed field.
+}
+
+// JQLSuggestionOptionsScheme represents the options used to get the JQL value suggestions in Jira.
+type JQLSuggestionOptionsScheme struct {
+	FieldName      string // The name of the field.
+	FieldValue     string // The partial value typed for the field.
+	PredicateName  string // The name of a CHANGED operator predicate, e.g. "by", "from" or "to".
+	PredicateValue string // The partial value typed for the predicate.
+}
+
+// JQLSuggestionPageScheme represents the suggestions of values of a JQL query in Jira.
+type JQLSuggestionPageScheme struct {
+	Results []*JQLSuggestionScheme `json:"results,omitempty"` // The suggested values.
+}
+
+// JQLSuggestionScheme represents a suggested value of a JQL query in Jira.
+type JQLSuggestionScheme struct {
+	Value       string `json:"value,omitempty"`       // The value, as used in the queries.
+	DisplayName string `json:"displayName,omitempty"` // The display name of the value, with the typed text highlighted in bold.
+}
+
+// JQLSanitizePayloadScheme represents the queries to sanitize in Jira.
+type JQLSanitizePayloadScheme struct {
+	Queries []*JQLSanitizeQueryScheme `json:"queries"` // The queries to sanitize.
+}
+
+// JQLSanitizeQueryScheme represents a query to sanitize in Jira.
+type JQLSanitizeQueryScheme struct {
+	Query     string `json:"query"`               // The query.
+	AccountID string `json:"accountId,omitempty"` // The account ID of the user the query is sanitized for, the current user when empty.
+}
+
+// JQLSanitizedPageScheme represents the sanitized queries in Jira.
+type JQLSanitizedPageScheme struct {
+	Queries []*JQLSanitizedQueryScheme `json:"queries,omitempty"` // The sanitized queries, in the order of the payload queries.
+}
+
+// JQLSanitizedQueryScheme represents a sanitized query in Jira, the entities the user can't view are replaced by their IDs.
+type JQLSanitizedQueryScheme struct {
+	InitialQuery   string                  `json:"initialQuery,omitempty"`   // The query to sanitize.
+	SanitizedQuery string                  `json:"sanitizedQuery,omitempty"` // The sanitized query, empty when the query is invalid.
+	Errors         *JQLSanitizeErrorScheme `json:"errors,omitempty"`         // The errors of the invalid query.
+	AccountID      string                  `json:"accountId,omitempty"`      // The account ID of the user the query was sanitized for.
+}
+
+// JQLSanitizeErrorScheme represents the errors of a query that could not be sanitized in Jira.
+type JQLSanitizeErrorScheme struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"` // The error messages.
+	Errors        map[string]string `json:"errors,omitempty"`        // The errors keyed by field.
+}
+
+// JQLPersonalDataMigrationScheme represents the queries converted to use account IDs instead of user names in Jira.
+type JQLPersonalDataMigrationScheme struct {
+	QueryStrings            []string                          `json:"queryStrings,omitempty"`            // The converted queries, in the order of the payload queries.
+	QueriesWithUnknownUsers []*JQLQueryWithUnknownUsersScheme `json:"queriesWithUnknownUsers,omitempty"` // The queries with users that could not be found.
+}
+
+// JQLQueryWithUnknownUsersScheme represents a converted query with users that could not be found in Jira.
+type JQLQueryWithUnknownUsersScheme struct {
+	OriginalQuery  string `json:"originalQuery,omitempty"`  // The query to convert.
+	ConvertedQuery string `json:"convertedQuery,omitempty"` // The converted query, the unknown users are replaced by "unknown".
+}
@@ -0,0 +1,123 @@
+package models
+
+// PrioritySchemePageScheme represents a page of priority schemes in Jira.
+type PrioritySchemePageScheme struct {
+	Self       string                  `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                  `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                     `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                     `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                     `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                    `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*PrioritySchemeScheme `json:"values,omitempty"`     // The priority schemes in the page.
+}
+
+// PrioritySchemeScheme represents a priority scheme in Jira.
+type PrioritySchemeScheme struct {
+	ID                string                           `json:"id,omitempty"`                // The ID of the priority scheme.
+	Self              string                           `json:"self,omitempty"`              // The URL of the priority scheme.
+	Name              string                           `json:"name,omitempty"`              // The name of the priority scheme.
+	Description       string                           `json:"description,omitempty"`       // The description of the priority scheme.
+	DefaultPriorityID string                           `json:"defaultPriorityId,omitempty"` // The ID of the default priority of the scheme.
+	IsDefault         bool                             `json:"isDefault,omitempty"`         // Indicates if the scheme is the default priority scheme.
+	Priorities        *PriorityWithSequencePageScheme  `json:"priorities,omitempty"`        // The priorities of the scheme, expanded with "priorities".
+	Projects          *PrioritySchemeProjectPageScheme `json:"projects,omitempty"`          // The projects using the scheme, expanded with "projects".
+}
+
+// PriorityWithSequencePageScheme represents a page of the priorities of a priority scheme in Jira.
+type PriorityWithSequencePageScheme struct {
+	Self       string                        `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                        `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                           `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int                           `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int                           `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool                          `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*PriorityWithSequenceScheme `json:"values,omitempty"`     // The priorities in the page.
+}
+
+// PriorityWithSequenceScheme represents a priority of a priority scheme in Jira, along with its position in the scheme.
+type PriorityWithSequenceScheme struct {
+	ID          string `json:"id,omitempty"`          // The ID of the priority.
+	Self        string `json:"self,omitempty"`        // The URL of the priority.
+	Name        string `json:"name,omitempty"`        // The name of the priority.
+	Description string `json:"description,omitempty"` // The description of the priority.
+	IconURL     string `json:"iconUrl,omitempty"`     // The URL of the icon of the priority.
+	StatusColor string `json:"statusColor,omitempty"` // The color of the priority.
+	Sequence    string `json:"sequence,omitempty"`    // The position of the priority in the scheme.
+	IsDefault   bool   `json:"isDefault,omitempty"`   // Indicates if the priority is the default priority of the scheme.
+}
+
+// PrioritySchemeProjectPageScheme represents a page of the projects using a priority scheme in Jira.
+type PrioritySchemeProjectPageScheme struct {
+	Self       string           `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string           `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int              `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*ProjectScheme `json:"values,omitempty"`     // The projects in the page.
+}
+
+// PrioritySchemeSearchOptions represents the options used to search priority schemes in Jira.
+type PrioritySchemeSearchOptions struct {
+	PriorityIDs []string // The IDs of the priorities of the schemes.
+	SchemeIDs   []string // The IDs of the priority schemes.
+	SchemeName  string   // The name of the schemes, case-insensitive partial match.
+	OnlyDefault bool     // Returns only the default priority scheme.
+	OrderBy     string   // The order of the schemes, e.g. "name" or "-name".
+	Expand      []string // The expand options, e.g. "priorities" or "projects".
+}
+
+// PrioritySchemeProjectSearchOptions represents the options used to search the projects of a priority scheme in Jira.
+type PrioritySchemeProjectSearchOptions struct {
+	ProjectIDs []string // The IDs of the projects.
+	Query      string   // The name or key of the projects, case-insensitive partial match.
+}
+
+// PrioritySchemePayloadScheme represents the payload used to create a priority scheme in Jira.
+type PrioritySchemePayloadScheme struct {
+	Name              string                 `json:"name,omitempty"`              // The name of the priority scheme.
+	Description       string                 `json:"description,omitempty"`       // The description of the priority scheme.
+	DefaultPriorityID int                    `json:"defaultPriorityId,omitempty"` // The ID of the default priority, it must be one of the priorities.
+	PriorityIDs       []int                  `json:"priorityIds,omitempty"`       // The IDs of the priorities of the scheme.
+	ProjectIDs        []int                  `json:"projectIds,omitempty"`        // The IDs of the projects using the scheme.
+	Mappings          *PriorityMappingScheme `json:"mappings,omitempty"`          // The mappings of the priorities used by the issues of the projects.
+}
+
+// PriorityMappingScheme represents the mappings of the priorities of the issues moved between priority schemes in Jira.
+type PriorityMappingScheme struct {
+	In  map[string]int `json:"in,omitempty"`  // The new priorities of the issues of the associated projects, keyed by current priority ID.
+	Out map[string]int `json:"out,omitempty"` // The new priorities of the issues of the disassociated projects, keyed by current priority ID.
+}
+
+// PrioritySchemeCreatedScheme represents the priority scheme created in Jira.
+type PrioritySchemeCreatedScheme struct {
+	ID   string      `json:"id,omitempty"`   // The ID of the priority scheme.
+	Task *TaskScheme `json:"task,omitempty"` // The task remapping the priorities of the issues, nil when no remapping is needed.
+}
+
+// PrioritySchemeUpdatePayloadScheme represents the payload used to update a priority scheme in Jira.
+type PrioritySchemeUpdatePayloadScheme struct {
+	Name              string                              `json:"name,omitempty"`              // The name of the priority scheme.
+	Description       string                              `json:"description,omitempty"`       // The description of the priority scheme.
+	DefaultPriorityID int                                 `json:"defaultPriorityId,omitempty"` // The ID of the default priority.
+	Priorities        *PrioritySchemeChangesPayloadScheme `json:"priorities,omitempty"`        // The priorities added to or removed from the scheme.
+	Projects          *PrioritySchemeChangesPayloadScheme `json:"projects,omitempty"`          // The projects associated with or disassociated from the scheme.
+	Mappings          *PriorityMappingScheme              `json:"mappings,omitempty"`          // The mappings of the priorities used by the issues of the projects.
+}
+
+// PrioritySchemeChangesPayloadScheme represents the priorities or projects added to and removed from a priority scheme in Jira.
+type PrioritySchemeChangesPayloadScheme struct {
+	Add    *PrioritySchemeIDsPayloadScheme `json:"add,omitempty"`    // The IDs to add.
+	Remove *PrioritySchemeIDsPayloadScheme `json:"remove,omitempty"` // The IDs to remove.
+}
+
+// PrioritySchemeIDsPayloadScheme represents the IDs of the priorities or projects of a priority scheme update in Jira.
+type PrioritySchemeIDsPayloadScheme struct {
+	IDs []int `json:"ids"` // The IDs of the priorities or projects.
+}
+
+// PrioritySchemeUpdatedScheme represents the result of a priority scheme update in Jira.
+type PrioritySchemeUpdatedScheme struct {
+	PriorityScheme *PrioritySchemeScheme `json:"priorityScheme,omitempty"` // The priority scheme.
+	Task           *TaskScheme           `json:"task,omitempty"`           // The task remapping the priorities of the issues, nil when no remapping is needed.
+}
@@ -15,3 +15,8 @@ type TaskScheme struct {
 	Finished       int64  `json:"finished"`       // The timestamp when the task finished.
 	LastUpdate     int64  `json:"lastUpdate"`     // The timestamp of the last update to the task.
 }
+
+// IsFinished reports whether the task reached a final status, the task statuses are the BulkTaskStatus values.
+func (t *TaskScheme) IsFinished() bool {
+	return IsFinalTaskStatus(t.Status)
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFinalTaskStatus(t *testing.T) {
+
+	testCases := []struct {
+		status string
+		want   bool
+	}{
+		{status: BulkTaskStatusEnqueued},
+		{status: BulkTaskStatusRunning},
+		{status: BulkTaskStatusCancelRequested},
+		{status: BulkTaskStatusComplete, want: true},
+		{status: BulkTaskStatusFailed, want: true},
+		{status: BulkTaskStatusCancelled, want: true},
+		{status: BulkTaskStatusDead, want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.status, func(t *testing.T) {
+
+			assert.Equal(t, testCase.want, IsFinalTaskStatus(testCase.status))
+			assert.Equal(t, testCase.want, (&TaskScheme{Status: testCase.status}).IsFinished())
+			assert.Equal(t, testCase.want, (&BulkOperationProgressScheme{Status: testCase.status}).IsFinished())
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// TimeTrackingProviderScheme represents a time tracking provider in Jira.
+type TimeTrackingProviderScheme struct {
+	Key  string `json:"key,omitempty"`  // The key of the time tracking provider, e.g. "JIRA".
+	Name string `json:"name,omitempty"` // The name of the time tracking provider.
+	URL  string `json:"url,omitempty"`  // The URL of the configuration page of the provider app.
+}
+
+// TimeTrackingConfigurationScheme represents the time tracking settings of Jira.
+type TimeTrackingConfigurationScheme struct {
+	WorkingHoursPerDay float64 `json:"workingHoursPerDay,omitempty"` // The number of hours in a working day.
+	WorkingDaysPerWeek float64 `json:"workingDaysPerWeek,omitempty"` // The number of days in a working week.
+	TimeFormat         string  `json:"timeFormat,omitempty"`         // The format of the durations, "pretty", "days" or "hours".
+	DefaultUnit        string  `json:"defaultUnit,omitempty"`        // The unit of the durations without unit, "minute", "hour", "day" or "week".
+}
+
+// hoursPerDay returns the working hours per day, 8 when the configuration is not set.
+func (c *TimeTrackingConfigurationScheme) hoursPerDay() float64 {
+
+	if c == nil || c.WorkingHoursPerDay <= 0 {
+		return 8
+	}
+
+	return c.WorkingHoursPerDay
+}
+
+// daysPerWeek returns the working days per week, 5 when the configuration is not set.
+func (c *TimeTrackingConfigurationScheme) daysPerWeek() float64 {
+
+	if c == nil || c.WorkingDaysPerWeek <= 0 {
+		return 5
+	}
+
+	return c.WorkingDaysPerWeek
+}
+
+// unit returns the length of a duration unit, e.g. "w", "d", "h" or "m", using the working hours and days.
+func (c *TimeTrackingConfigurationScheme) unit(unit string) (time.Duration, bool) {
+
+	switch strings.ToLower(unit) {
+	case "w", "week", "weeks":
+		return time.Duration(c.daysPerWeek() * c.hoursPerDay() * float64(time.Hour)), true
+	case "d", "day", "days":
+		return time.Duration(c.hoursPerDay() * float64(time.Hour)), true
+	case "h", "hour", "hours":
+		return time.Hour, true
+	case "m", "minute", "minutes":
+		return time.Minute, true
+	}
+
+	return 0, false
+}
+
+// TimeTrackingDuration represents a duration of the time tracking fields and worklogs, e.g. "1w 2d 3h 30m".
+//
+// The weeks and days of the Jira durations are working weeks and days, so the conversions
+// use the working hours per day and days per week of the time tracking configuration.
+type TimeTrackingDuration time.Duration
+
+// ParseTimeTrackingDuration parses a Jira duration such as "1w 2d 3h 30m", "1.5h" or "90m".
+//
+// The configuration provides the working hours per day and days per week, the Jira defaults (8 hours and 5 days)
+// are used when it's nil. The numbers without unit use the default unit of the configuration, minutes when not set.
+func ParseTimeTrackingDuration(value string, config *TimeTrackingConfigurationScheme) (TimeTrackingDuration, error) {
+
+	text := strings.TrimSpace(value)
+	if text == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, value)
+	}
+
+	var total float64
+	for text != "" {
+
+		numberEnd := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+		if numberEnd == -1 {
+			numberEnd = len(text)
+		}
+
+		number, err := strconv.ParseFloat(text[:numberEnd], 64)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, value)
+		}
+
+		text = strings.TrimLeft(text[numberEnd:], " ")
+
+		unitEnd := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsLetter(r) })
+		if unitEnd == -1 {
+			unitEnd = len(text)
+		}
+
+		unitName := text[:unitEnd]
+		if unitName == "" {
+
+			if config == nil || config.DefaultUnit == "" {
+				unitName = "m"
+			} else {
+				unitName = config.DefaultUnit
+			}
+		}
+
+		unit, ok := config.unit(unitName)
+		if !ok {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, value)
+		}
+
+		total += number * float64(unit)
+		text = strings.TrimLeft(text[unitEnd:], " ,")
+	}
+
+	return TimeTrackingDuration(math.Round(total)), nil
+}
+
+// TimeTrackingDurationFromSeconds returns the duration of a number of seconds, e.g. the timeSpentSeconds of a worklog.
+func TimeTrackingDurationFromSeconds(seconds int) TimeTrackingDuration {
+	return TimeTrackingDuration(time.Duration(seconds) * time.Second)
+}
+
+// Duration returns the duration as a time.Duration.
+func (d TimeTrackingDuration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+// Seconds returns the duration in whole seconds.
+func (d TimeTrackingDuration) Seconds() int {
+	return int(time.Duration(d) / time.Second)
+}
+
+// Format formats the duration as a Jira duration, e.g. "1w 2d 3h 30m", rounded down to the minute.
+//
+// The largest unit depends on the time format of the configuration, the hours are the largest unit
+// of the "hours" format and the days of the "days" format.
+func (d TimeTrackingDuration) Format(config *TimeTrackingConfigurationScheme) string {
+
+	units := []string{"w", "d", "h", "m"}
+	if config != nil {
+
+		switch config.TimeFormat {
+		case "days":
+			units = units[1:]
+		case "hours":
+			units = units[2:]
+		}
+	}
+
+	remaining := time.Duration(d)
+	if remaining < 0 {
+		remaining = -remaining
+	}
+
+	var parts []string
+	for _, unitName := range units {
+
+		unit, _ := config.unit(unitName)
+
+		count := remaining / unit
+		if count > 0 {
+			parts = append(parts, strconv.FormatInt(int64(count), 10)+unitName)
+			remaining -= count * unit
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0m"
+	}
+
+	if d < 0 {
+		parts[0] = "-" + parts[0]
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeTrackingDuration(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		value   string
+		config  *TimeTrackingConfigurationScheme
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "when the default configuration is used", value: "1w 2d 3h 30m", want: (40+16+3)*time.Hour + 30*time.Minute},
+		{name: "when the units are not separated", value: "2d3h", want: 19 * time.Hour},
+		{name: "when the duration has decimals", value: "1.5h", want: 90 * time.Minute},
+		{name: "when the duration uses the pretty format", value: "1 week, 2 days", config: &TimeTrackingConfigurationScheme{WorkingHoursPerDay: 7.5, WorkingDaysPerWeek: 5}, want: 52*time.Hour + 30*time.Minute},
+		{name: "when the duration has no unit", value: "2", config: &TimeTrackingConfigurationScheme{DefaultUnit: "hour"}, want: 2 * time.Hour},
+		{name: "when the duration has no unit nor configuration", value: "45", want: 45 * time.Minute},
+		{name: "when the unit is unknown", value: "3y", wantErr: true},
+		{name: "when the duration is empty", value: " ", wantErr: true},
+		{name: "when the number is invalid", value: "1..5h", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ParseTimeTrackingDuration(testCase.value, testCase.config)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDuration)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got.Duration())
+		})
+	}
+}
+
+func TestTimeTrackingDuration_Format(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		duration TimeTrackingDuration
+		config   *TimeTrackingConfigurationScheme
+		want     string
+	}{
+		{name: "when the default configuration is used", duration: TimeTrackingDuration((40+16+3)*time.Hour + 30*time.Minute), want: "1w 2d 3h 30m"},
+		{name: "when the days format is used", duration: TimeTrackingDuration(59 * time.Hour), config: &TimeTrackingConfigurationScheme{TimeFormat: "days"}, want: "7d 3h"},
+		{name: "when the hours format is used", duration: TimeTrackingDuration(59 * time.Hour), config: &TimeTrackingConfigurationScheme{TimeFormat: "hours"}, want: "59h"},
+		{name: "when the working days are shorter", duration: TimeTrackingDuration(15 * time.Hour), config: &TimeTrackingConfigurationScheme{WorkingHoursPerDay: 7.5}, want: "2d"},
+		{name: "when the duration is under a minute", duration: TimeTrackingDurationFromSeconds(59), want: "0m"},
+		{name: "when the duration is negative", duration: TimeTrackingDuration(-90 * time.Minute), want: "-1h 30m"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.duration.Format(testCase.config))
+		})
+	}
+}
+
+func TestWorklogADFPayloadScheme_Duration(t *testing.T) {
+
+	payload := &WorklogADFPayloadScheme{TimeSpent: "1d 2h"}
+
+	duration, err := payload.Duration(&TimeTrackingConfigurationScheme{WorkingHoursPerDay: 6})
+	assert.NoError(t, err)
+	assert.Equal(t, 8*time.Hour, duration.Duration())
+
+	payload.SetDuration(duration)
+	assert.Equal(t, "", payload.TimeSpent)
+	assert.Equal(t, 28800, payload.TimeSpentSeconds)
+
+	duration, err = payload.Duration(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 8*time.Hour, duration.Duration())
+}
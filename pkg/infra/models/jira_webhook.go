@@ -0,0 +1,84 @@
+package models
+
+import "time"
+
+// WebhookPageScheme represents a page of the dynamic webhooks registered by an app in Jira.
+type WebhookPageScheme struct {
+	Self       string           `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string           `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of results per page.
+	StartAt    int              `json:"startAt,omitempty"`    // The index of the first item returned in the page.
+	Total      int              `json:"total,omitempty"`      // The total number of items available.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*WebhookScheme `json:"values,omitempty"`     // The webhooks in the page.
+}
+
+// WebhookScheme represents a dynamic webhook registered in Jira.
+type WebhookScheme struct {
+	ID                      int      `json:"id,omitempty"`                      // The ID of the webhook.
+	JqlFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter of the issue events.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The IDs of the fields triggering the issue updated events.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The keys of the issue properties triggering the property events.
+	Events                  []string `json:"events,omitempty"`                  // The events of the webhook, e.g. "jira:issue_created".
+	ExpirationDate          int64    `json:"expirationDate,omitempty"`          // The UNIX timestamp, in milliseconds, of the webhook expiration.
+}
+
+// Expiration returns the expiration time of the webhook.
+func (w *WebhookScheme) Expiration() time.Time {
+	return time.UnixMilli(w.ExpirationDate)
+}
+
+// WebhookRegisterPayloadScheme represents the payload used to register dynamic webhooks in Jira.
+type WebhookRegisterPayloadScheme struct {
+	URL      string                  `json:"url,omitempty"`      // The URL of the app receiving the webhooks, relative to the app base URL.
+	Webhooks []*WebhookPayloadScheme `json:"webhooks,omitempty"` // The webhooks to register.
+}
+
+// WebhookPayloadScheme represents a dynamic webhook to register in Jira.
+type WebhookPayloadScheme struct {
+	JqlFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter of the issue events, required by the issue events.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The IDs of the fields triggering the issue updated events.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The keys of the issue properties triggering the property events.
+	Events                  []string `json:"events,omitempty"`                  // The events of the webhook.
+}
+
+// WebhookRegistrationScheme represents the results of a dynamic webhook registration in Jira.
+type WebhookRegistrationScheme struct {
+	WebhookRegistrationResult []*WebhookRegistrationResultScheme `json:"webhookRegistrationResult,omitempty"` // The results, in the order of the payload webhooks.
+}
+
+// WebhookRegistrationResultScheme represents the result of a dynamic webhook registration in Jira.
+type WebhookRegistrationResultScheme struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"` // The ID of the webhook, empty when the registration failed.
+	Errors           []string `json:"errors,omitempty"`           // The errors of the registration.
+}
+
+// WebhookIDsPayloadScheme represents the payload used to delete or refresh dynamic webhooks in Jira.
+type WebhookIDsPayloadScheme struct {
+	WebhookIDs []int `json:"webhookIds"` // The IDs of the webhooks.
+}
+
+// WebhookExpirationScheme represents the expiration of refreshed dynamic webhooks in Jira.
+type WebhookExpirationScheme struct {
+	ExpirationDate int64 `json:"expirationDate,omitempty"` // The UNIX timestamp, in milliseconds, of the new expiration.
+}
+
+// Expiration returns the expiration time of the refreshed webhooks.
+func (w *WebhookExpirationScheme) Expiration() time.Time {
+	return time.UnixMilli(w.ExpirationDate)
+}
+
+// FailedWebhookPageScheme represents a page of the webhooks that failed to be delivered in Jira.
+type FailedWebhookPageScheme struct {
+	MaxResults int                    `json:"maxResults,omitempty"` // The maximum number of results per page.
+	Next       string                 `json:"next,omitempty"`       // The URL of the next page, empty on the last page.
+	Values     []*FailedWebhookScheme `json:"values,omitempty"`     // The failed webhooks in the page.
+}
+
+// FailedWebhookScheme represents a webhook that failed to be delivered in Jira.
+type FailedWebhookScheme struct {
+	ID          string `json:"id,omitempty"`          // The ID of the webhook delivery.
+	Body        string `json:"body,omitempty"`        // The body of the webhook, only available when the webhook is less than 15 days old.
+	URL         string `json:"url,omitempty"`         // The URL the webhook was sent to.
+	FailureTime int64  `json:"failureTime,omitempty"` // The UNIX timestamp, in milliseconds, of the delivery failure.
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// WebhookEventScheme represents the fields shared by the webhook events sent by Jira.
+type WebhookEventScheme struct {
+	WebhookEvent      string `json:"webhookEvent,omitempty"`      // The name of the event, e.g. "jira:issue_created".
+	Timestamp         int64  `json:"timestamp,omitempty"`         // The UNIX timestamp, in milliseconds, of the event.
+	MatchedWebhookIDs []int  `json:"matchedWebhookIds,omitempty"` // The IDs of the dynamic webhooks matching the event.
+}
+
+// Time returns the time of the event.
+func (w *WebhookEventScheme) Time() time.Time {
+	return time.UnixMilli(w.Timestamp)
+}
+
+// WebhookIssueEventScheme represents an issue created, updated or deleted webhook event in Jira.
+//
+// The webhooks use the representation of the REST API v2, the rich text fields are sent as wiki markup.
+type WebhookIssueEventScheme struct {
+	WebhookEvent       string                       `json:"webhookEvent,omitempty"`          // The name of the event, e.g. "jira:issue_updated".
+	IssueEventTypeName string                       `json:"issue_event_type_name,omitempty"` // The type of the issue event, e.g. "issue_assigned" or "issue_commented".
+	Timestamp          int64                        `json:"timestamp,omitempty"`             // The UNIX timestamp, in milliseconds, of the event.
+	MatchedWebhookIDs  []int                        `json:"matchedWebhookIds,omitempty"`     // The IDs of the dynamic webhooks matching the event.
+	User               *UserScheme                  `json:"user,omitempty"`                  // The user who triggered the event.
+	Issue              *IssueSchemeV2               `json:"issue,omitempty"`                 // The issue.
+	Changelog          *IssueChangelogHistoryScheme `json:"changelog,omitempty"`             // The fields changed by the event.
+	Comment            *IssueCommentSchemeV2        `json:"comment,omitempty"`               // The comment added or edited by the event.
+}
+
+// WebhookCommentEventScheme represents a comment created, updated or deleted webhook event in Jira.
+type WebhookCommentEventScheme struct {
+	WebhookEvent      string                `json:"webhookEvent,omitempty"`      // The name of the event, e.g. "comment_created".
+	Timestamp         int64                 `json:"timestamp,omitempty"`         // The UNIX timestamp, in milliseconds, of the event.
+	MatchedWebhookIDs []int                 `json:"matchedWebhookIds,omitempty"` // The IDs of the dynamic webhooks matching the event.
+	Comment           *IssueCommentSchemeV2 `json:"comment,omitempty"`           // The comment.
+	Issue             *IssueSchemeV2        `json:"issue,omitempty"`             // The issue of the comment, with a subset of its fields.
+}
+
+// WebhookWorklogEventScheme represents a worklog created, updated or deleted webhook event in Jira.
+type WebhookWorklogEventScheme struct {
+	WebhookEvent      string                      `json:"webhookEvent,omitempty"`      // The name of the event, e.g. "worklog_updated".
+	Timestamp         int64                       `json:"timestamp,omitempty"`         // The UNIX timestamp, in milliseconds, of the event.
+	MatchedWebhookIDs []int                       `json:"matchedWebhookIds,omitempty"` // The IDs of the dynamic webhooks matching the event.
+	Worklog           *IssueWorklogRichTextScheme `json:"worklog,omitempty"`           // The worklog.
+}
+
+// WebhookSprintEventScheme represents a sprint created, updated, started, closed or deleted webhook event in Jira.
+type WebhookSprintEventScheme struct {
+	WebhookEvent string        `json:"webhookEvent,omitempty"` // The name of the event, e.g. "sprint_started".
+	Timestamp    int64         `json:"timestamp,omitempty"`    // The UNIX timestamp, in milliseconds, of the event.
+	Sprint       *SprintScheme `json:"sprint,omitempty"`       // The sprint.
+	OldValue     *SprintScheme `json:"oldValue,omitempty"`     // The sprint before the update, only sent by the updated events.
+}
+
+// WebhookBoardEventScheme represents a board created, updated, deleted or configuration changed webhook event in Jira.
+type WebhookBoardEventScheme struct {
+	WebhookEvent string       `json:"webhookEvent,omitempty"` // The name of the event, e.g. "board_configuration_changed".
+	Timestamp    int64        `json:"timestamp,omitempty"`    // The UNIX timestamp, in milliseconds, of the event.
+	Board        *BoardScheme `json:"board,omitempty"`        // The board.
+}
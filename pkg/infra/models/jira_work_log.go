@@ -19,6 +19,21 @@ type WorklogADFPayloadScheme struct {
 	TimeSpentSeconds int                           `json:"timeSpentSeconds,omitempty"` // The time spent on the work in seconds.
 }
 
+// SetDuration sets the time spent of the worklog in seconds, the Jira duration is cleared so the seconds are used.
+func (w *WorklogADFPayloadScheme) SetDuration(duration TimeTrackingDuration) {
+	w.TimeSpent, w.TimeSpentSeconds = "", duration.Seconds()
+}
+
+// Duration returns the time spent of the worklog, the seconds take precedence over the Jira duration.
+func (w *WorklogADFPayloadScheme) Duration(config *TimeTrackingConfigurationScheme) (TimeTrackingDuration, error) {
+
+	if w.TimeSpentSeconds != 0 {
+		return TimeTrackingDurationFromSeconds(w.TimeSpentSeconds), nil
+	}
+
+	return ParseTimeTrackingDuration(w.TimeSpent, config)
+}
+
 // WorklogRichTextPayloadScheme represents the payload for a worklog with rich text content in Jira.
 type WorklogRichTextPayloadScheme struct {
 	Comment          *CommentPayloadSchemeV2       `json:"comment,omitempty"`          // The comment for the worklog in rich text format.
@@ -28,6 +43,21 @@ type WorklogRichTextPayloadScheme struct {
 	TimeSpentSeconds int                           `json:"timeSpentSeconds,omitempty"` // The time spent on the work in seconds.
 }
 
+// SetDuration sets the time spent of the worklog in seconds, the Jira duration is cleared so the seconds are used.
+func (w *WorklogRichTextPayloadScheme) SetDuration(duration TimeTrackingDuration) {
+	w.TimeSpent, w.TimeSpentSeconds = "", duration.Seconds()
+}
+
+// Duration returns the time spent of the worklog, the seconds take precedence over the Jira duration.
+func (w *WorklogRichTextPayloadScheme) Duration(config *TimeTrackingConfigurationScheme) (TimeTrackingDuration, error) {
+
+	if w.TimeSpentSeconds != 0 {
+		return TimeTrackingDurationFromSeconds(w.TimeSpentSeconds), nil
+	}
+
+	return ParseTimeTrackingDuration(w.TimeSpent, config)
+}
+
 // ChangedWorklogPageScheme represents a page of changed worklogs in Jira.
 type ChangedWorklogPageScheme struct {
 	Since    int                     `json:"since,omitempty"`    // The timestamp of the start of the period.
@@ -13,12 +13,13 @@ type WorkflowStatusDetailPageScheme struct {
 
 // WorkflowStatusDetailScheme represents a workflow status detail in Jira.
 type WorkflowStatusDetailScheme struct {
-	ID             string                     `json:"id,omitempty"`             // The ID of the workflow status.
-	Name           string                     `json:"name,omitempty"`           // The name of the workflow status.
-	StatusCategory string                     `json:"statusCategory,omitempty"` // The status category of the workflow status.
-	Scope          *WorkflowStatusScopeScheme `json:"scope,omitempty"`          // The scope of the workflow status.
-	Description    string                     `json:"description,omitempty"`    // The description of the workflow status.
-	Usages         []*ProjectIssueTypesScheme `json:"usages,omitempty"`         // The usages of the workflow status.
+	ID              string                     `json:"id,omitempty"`              // The ID of the workflow status.
+	Name            string                     `json:"name,omitempty"`            // The name of the workflow status.
+	StatusCategory  string                     `json:"statusCategory,omitempty"`  // The status category of the workflow status.
+	Scope           *WorkflowStatusScopeScheme `json:"scope,omitempty"`           // The scope of the workflow status.
+	Description     string                     `json:"description,omitempty"`     // The description of the workflow status.
+	Usages          []*ProjectIssueTypesScheme `json:"usages,omitempty"`          // The usages of the workflow status.
+	StatusReference string                     `json:"statusReference,omitempty"` // The reference of the status used on the workflows.
 }
 
 // WorkflowStatusScopeScheme represents the scope of a workflow status in Jira.
@@ -70,3 +71,74 @@ type StatusDetailScheme struct {
 	StatusCategory *StatusCategoryScheme      `json:"statusCategory,omitempty"` // The status category of the status detail.
 	Scope          *WorkflowStatusScopeScheme `json:"scope,omitempty"`          // The scope of the status detail.
 }
+
+// The keys of the status categories in Jira.
+const (
+	StatusCategoryToDo       = "new"           // The statuses of the work not started.
+	StatusCategoryInProgress = "indeterminate" // The statuses of the work in progress.
+	StatusCategoryDone       = "done"          // The statuses of the work done.
+)
+
+// StatusCategoryIndex maps the status IDs to their status categories.
+type StatusCategoryIndex map[string]*StatusCategoryScheme
+
+// NewStatusCategoryIndex indexes the categories of the statuses, e.g. the statuses returned by the Bulk method.
+func NewStatusCategoryIndex(statuses []*StatusDetailScheme) StatusCategoryIndex {
+
+	index := make(StatusCategoryIndex, len(statuses))
+	for _, status := range statuses {
+
+		if status.StatusCategory != nil {
+			index[status.ID] = status.StatusCategory
+		}
+	}
+
+	return index
+}
+
+// Category returns the category of a status, nil when the status is unknown.
+func (s StatusCategoryIndex) Category(statusID string) *StatusCategoryScheme {
+	return s[statusID]
+}
+
+// IsDone indicates if a status belongs to the done category.
+func (s StatusCategoryIndex) IsDone(statusID string) bool {
+	return s.Is(statusID, StatusCategoryDone)
+}
+
+// Is indicates if a status belongs to the category with the key, e.g. StatusCategoryInProgress.
+func (s StatusCategoryIndex) Is(statusID, categoryKey string) bool {
+
+	category, ok := s[statusID]
+	return ok && category.Key == categoryKey
+}
+
+// StatusUsagePageScheme represents a page of the entities using a status in Jira.
+type StatusUsagePageScheme struct {
+	NextPageToken string                    `json:"nextPageToken,omitempty"` // The token of the next page, empty on the last page.
+	Values        []*StatusUsageValueScheme `json:"values,omitempty"`        // The entities of the page.
+}
+
+// StatusUsageValueScheme represents an entity using a status in Jira.
+type StatusUsageValueScheme struct {
+	ID string `json:"id,omitempty"` // The ID of the project, issue type or workflow.
+}
+
+// StatusProjectUsageScheme represents the projects using a status in Jira.
+type StatusProjectUsageScheme struct {
+	StatusID string                 `json:"statusId,omitempty"` // The ID of the status.
+	Projects *StatusUsagePageScheme `json:"projects,omitempty"` // The page of the projects.
+}
+
+// StatusIssueTypeUsageScheme represents the issue types of a project using a status in Jira.
+type StatusIssueTypeUsageScheme struct {
+	StatusID   string                 `json:"statusId,omitempty"`   // The ID of the status.
+	ProjectID  string                 `json:"projectId,omitempty"`  // The ID of the project.
+	IssueTypes *StatusUsagePageScheme `json:"issueTypes,omitempty"` // The page of the issue types.
+}
+
+// StatusWorkflowUsageScheme represents the workflows using a status in Jira.
+type StatusWorkflowUsageScheme struct {
+	StatusID  string                 `json:"statusId,omitempty"`  // The ID of the status.
+	Workflows *StatusUsagePageScheme `json:"workflows,omitempty"` // The page of the workflows.
+}
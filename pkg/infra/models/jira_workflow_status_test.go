@@ -0,0 +1,25 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusCategoryIndex(t *testing.T) {
+
+	index := NewStatusCategoryIndex([]*StatusDetailScheme{
+		{ID: "1", Name: "Open", StatusCategory: &StatusCategoryScheme{ID: 2, Key: StatusCategoryToDo}},
+		{ID: "3", Name: "In Progress", StatusCategory: &StatusCategoryScheme{ID: 4, Key: StatusCategoryInProgress}},
+		{ID: "10001", Name: "Shipped", StatusCategory: &StatusCategoryScheme{ID: 3, Key: StatusCategoryDone}},
+		{ID: "10002", Name: "Unknown"},
+	})
+
+	assert.Len(t, index, 3)
+	assert.True(t, index.IsDone("10001"))
+	assert.False(t, index.IsDone("3"))
+	assert.True(t, index.Is("3", StatusCategoryInProgress))
+	assert.False(t, index.IsDone("10002"))
+	assert.Nil(t, index.Category("404"))
+	assert.Equal(t, 2, index.Category("1").ID)
+}
@@ -11,3 +11,9 @@ type Connector interface {
 	NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error)
 	Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error)
 }
+
+// Streamer is implemented by the connectors able to return the response bodies unread,
+// e.g. to copy an attachment without buffering it.
+type Streamer interface {
+	Stream(request *http.Request) (*models.ResponseScheme, error)
+}
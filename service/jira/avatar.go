@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"context"
+	"io"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// AvatarConnector represents the avatars of the projects, issue types and priorities.
+// Use it to list, upload, set and delete the avatars.
+type AvatarConnector interface {
+
+	// Gets returns the system and custom avatars of a project, issue type or priority.
+	//
+	// The avatarType is models.AvatarTypeProject, models.AvatarTypeIssueType or models.AvatarTypePriority.
+	//
+	// GET /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}
+	Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error)
+
+	// System returns the system avatars of a type.
+	//
+	// GET /rest/api/{2-3}/avatar/{type}/system
+	System(ctx context.Context, avatarType string) (*model.AvatarsScheme, *model.ResponseScheme, error)
+
+	// Upload uploads a custom avatar for a project, issue type or priority.
+	//
+	// The image is streamed to Jira, its content type is detected from its first bytes.
+	//
+	// The crop selects the square area of the image used as avatar, the whole image is used when nil.
+	//
+	// POST /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}
+	Upload(ctx context.Context, avatarType, entityID string, image io.Reader, crop *model.AvatarCropScheme) (*model.AvatarScheme, *model.ResponseScheme, error)
+
+	// Set sets the avatar of a project or issue type.
+	//
+	// The projects are identified by ID or key, the priorities can't be set through this method.
+	//
+	// PUT /rest/api/{2-3}/project/{projectKeyOrID}/avatar
+	//
+	// PUT /rest/api/{2-3}/issuetype/{issueTypeID}
+	Set(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error)
+
+	// Delete deletes a custom avatar of a project, issue type or priority.
+	//
+	// DELETE /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityID}/avatar/{avatarID}
+	Delete(ctx context.Context, avatarType, entityID, avatarID string) (*model.ResponseScheme, error)
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// BulkConnector the interface for the bulk operation methods of the Jira Service.
+type BulkConnector interface {
+
+	// Fields returns the fields that can be edited in bulk on the issues provided.
+	//
+	// GET /rest/api/{2-3}/bulk/issues/fields
+	Fields(ctx context.Context, issueIDsOrKeys []string, searchText, startingAfter, endingBefore string) (*model.BulkEditableFieldPageScheme, *model.ResponseScheme, error)
+
+	// Edit edits the fields of up to 1000 issues, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/fields
+	Edit(ctx context.Context, payload *model.BulkEditPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Move moves up to 1000 issues to other projects or issue types, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/move
+	Move(ctx context.Context, payload *model.BulkMovePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Transitions returns the transitions available for the issues provided, grouped by workflow.
+	//
+	// GET /rest/api/{2-3}/bulk/issues/transition
+	Transitions(ctx context.Context, issueIDsOrKeys []string, startingAfter, endingBefore string) (*model.BulkTransitionPageScheme, *model.ResponseScheme, error)
+
+	// Transition transitions up to 1000 issues, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/transition
+	Transition(ctx context.Context, payload *model.BulkTransitionPayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Delete deletes up to 1000 issues, including their subtasks, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/delete
+	Delete(ctx context.Context, payload *model.BulkDeletePayloadScheme) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Watch adds the user as a watcher of up to 1000 issues, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/watch
+	Watch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Unwatch removes the user from the watchers of up to 1000 issues, the operation runs asynchronously.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/unwatch
+	Unwatch(ctx context.Context, issueIDsOrKeys []string) (*model.BulkTaskScheme, *model.ResponseScheme, error)
+
+	// Progress returns the progress of a bulk operation, including the issues processed and the failed ones.
+	//
+	// GET /rest/api/{2-3}/bulk/queue/{taskID}
+	Progress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error)
+}
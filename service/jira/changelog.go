@@ -0,0 +1,25 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type ChangelogConnector interface {
+
+	// Gets returns a paginated list of all changelogs for an issue sorted by date, starting from the oldest.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+	Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error)
+
+	// List returns changelogs for an issue specified by a list of changelog IDs.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+	List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error)
+
+	// BulkFetch returns a paginated list of the changelogs of several issues, optionally filtered by fields.
+	//
+	// POST /rest/api/{2-3}/changelog/bulkfetch
+	BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchScheme, *model.ResponseScheme, error)
+}
@@ -63,3 +63,34 @@ type DashboardConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/dashboards#update-dashboard
 	Update(ctx context.Context, dashboardID string, payload *model.DashboardPayloadScheme) (*model.DashboardScheme, *model.ResponseScheme, error)
 }
+
+// DashboardGadgetConnector represents the gadgets of the Jira dashboards.
+// Use it to list, add, update and remove the gadgets, the properties of the dashboard items are managed
+// by the entity property connector using the model.PropertyEntityDashboardItem kind.
+type DashboardGadgetConnector interface {
+
+	// Gets returns the gadgets of a dashboard, optionally filtered by gadget type or ID.
+	//
+	// GET /rest/api/{2-3}/dashboard/{dashboardID}/gadget
+	Gets(ctx context.Context, dashboardID string, options *model.DashboardGadgetSearchOptionsScheme) (*model.DashboardGadgetPageScheme, *model.ResponseScheme, error)
+
+	// Add adds a gadget to a dashboard, the gadget type is identified by its module key or URI.
+	//
+	// POST /rest/api/{2-3}/dashboard/{dashboardID}/gadget
+	Add(ctx context.Context, dashboardID string, payload *model.DashboardGadgetPayloadScheme) (*model.DashboardGadgetScheme, *model.ResponseScheme, error)
+
+	// Update changes the title, color or position of a gadget.
+	//
+	// PUT /rest/api/{2-3}/dashboard/{dashboardID}/gadget/{gadgetID}
+	Update(ctx context.Context, dashboardID string, gadgetID int, payload *model.DashboardGadgetPayloadScheme) (*model.ResponseScheme, error)
+
+	// Remove removes a gadget from a dashboard, the gadgets below it move up.
+	//
+	// DELETE /rest/api/{2-3}/dashboard/{dashboardID}/gadget/{gadgetID}
+	Remove(ctx context.Context, dashboardID string, gadgetID int) (*model.ResponseScheme, error)
+
+	// Available returns the gadgets that can be added to the dashboards.
+	//
+	// GET /rest/api/{2-3}/dashboard/gadgets
+	Available(ctx context.Context) (*model.AvailableDashboardGadgetPageScheme, *model.ResponseScheme, error)
+}
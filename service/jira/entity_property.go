@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// EntityPropertyConnector represents the properties of the Jira entities.
+// Use it to store custom data against issues, projects, issue types, users, comments, worklogs, dashboard items and apps.
+//
+// The properties of the apps are stored at /rest/atlassian-connect/1/addons/{addonKey}/properties.
+type EntityPropertyConnector interface {
+
+	// Gets returns the keys of the properties of an entity.
+	//
+	// GET /rest/api/{2-3}/{entity}/properties
+	Gets(ctx context.Context, entity *model.PropertyEntityScheme) (*model.PropertyPageScheme, *model.ResponseScheme, error)
+
+	// Get returns the key and value of a property of an entity.
+	//
+	// Decode the value with models.EntityPropertyValue, e.g. models.EntityPropertyValue[*State](property).
+	//
+	// GET /rest/api/{2-3}/{entity}/properties/{propertyKey}
+	Get(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error)
+
+	// Set sets the value of a property of an entity, the value must be a valid JSON of up to 32768 characters.
+	//
+	// PUT /rest/api/{2-3}/{entity}/properties/{propertyKey}
+	Set(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string, value interface{}) (*model.ResponseScheme, error)
+
+	// Delete deletes a property of an entity.
+	//
+	// DELETE /rest/api/{2-3}/{entity}/properties/{propertyKey}
+	Delete(ctx context.Context, entity *model.PropertyEntityScheme, propertyKey string) (*model.ResponseScheme, error)
+
+	// BulkSet sets the value of a property on the issues matching the filter.
+	//
+	// The property is updated asynchronously.
+	//
+	// PUT /rest/api/{2-3}/issue/properties/{propertyKey}
+	BulkSet(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkSetPayloadScheme) (*model.ResponseScheme, error)
+
+	// BulkDelete deletes a property from the issues matching the filter.
+	//
+	// The property is deleted asynchronously.
+	//
+	// DELETE /rest/api/{2-3}/issue/properties/{propertyKey}
+	BulkDelete(ctx context.Context, propertyKey string, payload *model.IssuePropertyBulkDeletePayloadScheme) (*model.ResponseScheme, error)
+}
@@ -0,0 +1,28 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ExpressionConnector represents the Jira expressions.
+// Use it to evaluate and analyse the expressions.
+type ExpressionConnector interface {
+
+	// Evaluate evaluates a Jira expression and returns its value.
+	//
+	// The issues of a JQL context are paginated by token, use ExpressionJQLContextScheme.Next to fetch the next pages.
+	//
+	// Decode the value with models.ExpressionValue, e.g. models.ExpressionValue[[]string](result).
+	//
+	// POST /rest/api/{2-3}/expression/evaluate
+	Evaluate(ctx context.Context, payload *model.ExpressionEvaluationPayloadScheme, expand []string) (*model.ExpressionEvaluationScheme, *model.ResponseScheme, error)
+
+	// Analyse analyses Jira expressions for syntax errors, type errors or complexity.
+	//
+	// The check parameter is "syntax", "type" or "complexity", the type check is used when empty.
+	//
+	// POST /rest/api/{2-3}/expression/analyse
+	Analyse(ctx context.Context, payload *model.ExpressionAnalysisPayloadScheme, check string) (*model.ExpressionAnalysisScheme, *model.ResponseScheme, error)
+}
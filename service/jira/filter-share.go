@@ -17,6 +17,8 @@ type FilterSharingConnector interface {
 
 	// SetScope sets the default sharing for new filters and dashboards for a user.
 	//
+	// The scope is one of models.ShareScopeGlobal, models.ShareScopeAuthenticated or models.ShareScopePrivate.
+	//
 	// PUT /rest/api/{2-3}/filter/defaultShareScope
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/filters/sharing#set-default-share-scope
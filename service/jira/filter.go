@@ -68,4 +68,26 @@ type FilterConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/filters#change-filter-owner
 	Change(ctx context.Context, filterID int, accountID string) (*model.ResponseScheme, error)
+
+	// Columns returns the columns of the issue navigator of a filter.
+	//
+	// GET /rest/api/{2-3}/filter/{filterID}/columns
+	Columns(ctx context.Context, filterID int) ([]*model.FilterColumnScheme, *model.ResponseScheme, error)
+
+	// SetColumns sets the columns of the issue navigator of a filter, in the order of the field IDs.
+	//
+	// PUT /rest/api/{2-3}/filter/{filterID}/columns
+	SetColumns(ctx context.Context, filterID int, columns []string) (*model.ResponseScheme, error)
+
+	// ResetColumns resets the columns of a filter to the default columns of the user.
+	//
+	// DELETE /rest/api/{2-3}/filter/{filterID}/columns
+	ResetColumns(ctx context.Context, filterID int) (*model.ResponseScheme, error)
+
+	// Subscriptions returns the users and groups subscribed to a filter.
+	//
+	// The subscriptions are managed in Jira, the REST API only returns them as an expansion of the filter.
+	//
+	// GET /rest/api/{2-3}/filter/{filterID}?expand=subscriptions
+	Subscriptions(ctx context.Context, filterID int) (*model.FilterSubscriptionPageScheme, *model.ResponseScheme, error)
 }
@@ -112,6 +112,16 @@ type IssueRichTextConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV2) (*model.ResponseScheme, error)
+
+	// TransitionTo moves an issue to a status, using its name or ID.
+	//
+	// When the status cannot be reached with a single transition, the shortest path is found using the issue workflow
+	// and the intermediate transitions are performed.
+	//
+	// The fields of the transition screens are requested using the options callback.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+	TransitionTo(ctx context.Context, issueKeyOrID, statusNameOrID string, options *model.IssueTransitionToOptionsV2) (*model.IssueTransitionPathScheme, *model.ResponseScheme, error)
 }
 
 type IssueADFConnector interface {
@@ -168,4 +178,14 @@ type IssueADFConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV3) (*model.ResponseScheme, error)
+
+	// TransitionTo moves an issue to a status, using its name or ID.
+	//
+	// When the status cannot be reached with a single transition, the shortest path is found using the issue workflow
+	// and the intermediate transitions are performed.
+	//
+	// The fields of the transition screens are requested using the options callback.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
+	TransitionTo(ctx context.Context, issueKeyOrID, statusNameOrID string, options *model.IssueTransitionToOptionsV3) (*model.IssueTransitionPathScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// IssueSecuritySchemeConnector represents the issue security schemes of Jira.
+// Use it to manage the schemes, their security levels and members, and the projects using them.
+type IssueSecuritySchemeConnector interface {
+
+	// Gets returns all issue security schemes.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes
+	Gets(ctx context.Context) (*model.IssueSecuritySchemesScheme, *model.ResponseScheme, error)
+
+	// Get returns an issue security scheme along with its security levels.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Get(ctx context.Context, schemeID string) (*model.IssueSecuritySchemeScheme, *model.ResponseScheme, error)
+
+	// Search returns a paginated list of issue security schemes, along with the projects using them.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/search
+	Search(ctx context.Context, options *model.IssueSecuritySchemeSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemePageScheme, *model.ResponseScheme, error)
+
+	// Create creates an issue security scheme, along with its security levels and members.
+	//
+	// POST /rest/api/{2-3}/issuesecurityschemes
+	Create(ctx context.Context, payload *model.IssueSecuritySchemePayloadScheme) (*model.IssueSecuritySchemeCreatedScheme, *model.ResponseScheme, error)
+
+	// Update updates the name and description of an issue security scheme.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Update(ctx context.Context, schemeID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error)
+
+	// Delete deletes an issue security scheme.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}
+	Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error)
+
+	// Level returns a security level.
+	//
+	// GET /rest/api/{2-3}/securitylevel/{levelID}
+	Level(ctx context.Context, levelID string) (*model.IssueSecurityLevelScheme, *model.ResponseScheme, error)
+
+	// Levels returns a paginated list of security levels, filtered by ID and scheme.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level
+	Levels(ctx context.Context, options *model.IssueSecurityLevelSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelPageScheme, *model.ResponseScheme, error)
+
+	// AddLevels adds security levels, along with their members, to an issue security scheme.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level
+	AddLevels(ctx context.Context, schemeID string, payload *model.IssueSecurityLevelsPayloadScheme) (*model.ResponseScheme, error)
+
+	// UpdateLevel updates the name and description of a security level.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+	UpdateLevel(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityUpdatePayloadScheme) (*model.ResponseScheme, error)
+
+	// DeleteLevel deletes a security level, the issues using it are moved to the replaceWith level when it's provided.
+	//
+	// The deletion is asynchronous, use the task service to follow its progress.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}
+	DeleteLevel(ctx context.Context, schemeID, levelID, replaceWith string) (*model.TaskScheme, *model.ResponseScheme, error)
+
+	// SetDefaultLevels sets the default security level of issue security schemes.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/level/default
+	SetDefaultLevels(ctx context.Context, payload *model.IssueSecurityLevelDefaultsPayloadScheme) (*model.ResponseScheme, error)
+
+	// Members returns a paginated list of security level members, filtered by ID, scheme and level.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/level/member
+	Members(ctx context.Context, options *model.IssueSecurityLevelMemberSearchOptions, startAt, maxResults int) (*model.IssueSecurityLevelMemberPageScheme, *model.ResponseScheme, error)
+
+	// AddMembers adds members to a security level.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member
+	AddMembers(ctx context.Context, schemeID, levelID string, payload *model.IssueSecurityLevelMembersPayloadScheme) (*model.ResponseScheme, error)
+
+	// RemoveMember removes a member from a security level.
+	//
+	// DELETE /rest/api/{2-3}/issuesecurityschemes/{schemeID}/level/{levelID}/member/{memberID}
+	RemoveMember(ctx context.Context, schemeID, levelID, memberID string) (*model.ResponseScheme, error)
+
+	// Projects returns a paginated list of the projects associated with issue security schemes.
+	//
+	// GET /rest/api/{2-3}/issuesecurityschemes/project
+	Projects(ctx context.Context, options *model.IssueSecuritySchemeAssociationSearchOptions, startAt, maxResults int) (*model.IssueSecuritySchemeAssociationPageScheme, *model.ResponseScheme, error)
+
+	// Associate associates an issue security scheme with a project, the security levels of the issues are remapped.
+	//
+	// The association is asynchronous, use the task service to follow its progress.
+	//
+	// PUT /rest/api/{2-3}/issuesecurityschemes/project
+	Associate(ctx context.Context, payload *model.IssueSecuritySchemeAssociatePayloadScheme) (*model.TaskScheme, *model.ResponseScheme, error)
+}
@@ -15,4 +15,26 @@ type JQLConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/jql#parse-jql-query
 	Parse(ctx context.Context, validationType string, JqlQueries []string) (*models.ParsedQueryPageScheme, *models.ResponseScheme, error)
+
+	// Autocomplete returns the fields, functions and reserved words used to build the JQL queries.
+	//
+	// The payload filters the fields by project and is sent as a POST request, the fields of all the projects are returned when it's nil.
+	//
+	// GET /rest/api/{2-3}/jql/autocompletedata
+	Autocomplete(ctx context.Context, payload *models.JQLReferenceDataPayloadScheme) (*models.JQLReferenceDataScheme, *models.ResponseScheme, error)
+
+	// Suggestions returns the suggested values of a field or of a CHANGED operator predicate, for the text typed by the user.
+	//
+	// GET /rest/api/{2-3}/jql/autocompletedata/suggestions
+	Suggestions(ctx context.Context, options *models.JQLSuggestionOptionsScheme) (*models.JQLSuggestionPageScheme, *models.ResponseScheme, error)
+
+	// Sanitize sanitizes JQL queries, the entities the user can't view are replaced by their IDs.
+	//
+	// POST /rest/api/{2-3}/jql/sanitize
+	Sanitize(ctx context.Context, payload *models.JQLSanitizePayloadScheme) (*models.JQLSanitizedPageScheme, *models.ResponseScheme, error)
+
+	// Convert converts the user names and user keys of JQL queries to account IDs, e.g. to migrate the queries of the saved filters.
+	//
+	// POST /rest/api/{2-3}/jql/pdcleaner
+	Convert(ctx context.Context, queries []string) (*models.JQLPersonalDataMigrationScheme, *models.ResponseScheme, error)
 }
@@ -22,3 +22,55 @@ type PriorityConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/priorities#get-priority
 	Get(ctx context.Context, priorityID string) (*model.PriorityScheme, *model.ResponseScheme, error)
 }
+
+// PrioritySchemeConnector is the interface for the priority scheme methods of the Jira Service.
+type PrioritySchemeConnector interface {
+
+	// Gets returns a paginated list of priority schemes.
+	//
+	// GET /rest/api/{2-3}/priorityscheme
+	Gets(ctx context.Context, options *model.PrioritySchemeSearchOptions, startAt, maxResults int) (*model.PrioritySchemePageScheme, *model.ResponseScheme, error)
+
+	// Create creates a priority scheme.
+	//
+	// The priorities of the issues of the projects are remapped asynchronously when needed, use the task service to follow the task returned.
+	//
+	// POST /rest/api/{2-3}/priorityscheme
+	Create(ctx context.Context, payload *model.PrioritySchemePayloadScheme) (*model.PrioritySchemeCreatedScheme, *model.ResponseScheme, error)
+
+	// Update updates a priority scheme, including its priorities and projects.
+	//
+	// The priorities of the issues of the projects are remapped asynchronously when needed, use the task service to follow the task returned.
+	//
+	// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+	Update(ctx context.Context, schemeID string, payload *model.PrioritySchemeUpdatePayloadScheme) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error)
+
+	// Delete deletes a priority scheme, the scheme must not be used by any project.
+	//
+	// DELETE /rest/api/{2-3}/priorityscheme/{schemeID}
+	Delete(ctx context.Context, schemeID string) (*model.ResponseScheme, error)
+
+	// Priorities returns a paginated list of the priorities of a priority scheme.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/{schemeID}/priorities
+	Priorities(ctx context.Context, schemeID string, startAt, maxResults int) (*model.PriorityWithSequencePageScheme, *model.ResponseScheme, error)
+
+	// Projects returns a paginated list of the projects using a priority scheme.
+	//
+	// GET /rest/api/{2-3}/priorityscheme/{schemeID}/projects
+	Projects(ctx context.Context, schemeID string, options *model.PrioritySchemeProjectSearchOptions, startAt, maxResults int) (*model.PrioritySchemeProjectPageScheme, *model.ResponseScheme, error)
+
+	// Associate associates projects with a priority scheme, the mappings set the new priorities of the issues of the projects.
+	//
+	// The priorities of the issues are remapped asynchronously when needed, use the task service to follow the task returned.
+	//
+	// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+	Associate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error)
+
+	// Disassociate disassociates projects from a priority scheme, the projects use the default priority scheme.
+	//
+	// The mappings set the new priorities of the issues of the projects, they are remapped asynchronously when needed.
+	//
+	// PUT /rest/api/{2-3}/priorityscheme/{schemeID}
+	Disassociate(ctx context.Context, schemeID string, projectIDs []int, mappings map[string]int) (*model.PrioritySchemeUpdatedScheme, *model.ResponseScheme, error)
+}
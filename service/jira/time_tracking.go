@@ -0,0 +1,39 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// TimeTrackingConnector represents the time tracking configuration of Jira.
+// Use it to search, select and configure the time tracking provider.
+type TimeTrackingConnector interface {
+
+	// Get returns the time tracking provider that is currently selected.
+	//
+	// The provider is nil when time tracking is disabled.
+	//
+	// GET /rest/api/{2-3}/configuration/timetracking
+	Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error)
+
+	// Gets returns all time tracking providers.
+	//
+	// GET /rest/api/{2-3}/configuration/timetracking/list
+	Gets(ctx context.Context) ([]*model.TimeTrackingProviderScheme, *model.ResponseScheme, error)
+
+	// Select selects a time tracking provider.
+	//
+	// PUT /rest/api/{2-3}/configuration/timetracking
+	Select(ctx context.Context, payload *model.TimeTrackingProviderScheme) (*model.ResponseScheme, error)
+
+	// Settings returns the time tracking settings, the working hours and days are used to convert the durations.
+	//
+	// GET /rest/api/{2-3}/configuration/timetracking/options
+	Settings(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error)
+
+	// UpdateSettings sets the time tracking settings.
+	//
+	// PUT /rest/api/{2-3}/configuration/timetracking/options
+	UpdateSettings(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,41 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WebhookConnector represents the dynamic webhooks of the Jira apps.
+// Use it to register, list, refresh and delete the webhooks of the calling app.
+type WebhookConnector interface {
+
+	// Gets returns a paginated list of the dynamic webhooks registered by the calling app.
+	//
+	// GET /rest/api/{2-3}/webhook
+	Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error)
+
+	// Register registers dynamic webhooks, the webhooks expire after 30 days unless they're refreshed.
+	//
+	// Only Connect and OAuth 2.0 apps can register webhooks.
+	//
+	// POST /rest/api/{2-3}/webhook
+	Register(ctx context.Context, payload *model.WebhookRegisterPayloadScheme) (*model.WebhookRegistrationScheme, *model.ResponseScheme, error)
+
+	// Delete removes the dynamic webhooks registered by the calling app.
+	//
+	// DELETE /rest/api/{2-3}/webhook
+	Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error)
+
+	// Refresh extends the life of the dynamic webhooks, they expire 30 days after the refresh.
+	//
+	// PUT /rest/api/{2-3}/webhook/refresh
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error)
+
+	// Failed returns the webhooks that failed to be delivered in the last 72 hours, sorted by failure time.
+	//
+	// The after parameter is the UNIX timestamp, in milliseconds, of the last failure of the previous page.
+	//
+	// GET /rest/api/{2-3}/webhook/failed
+	Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error)
+}
@@ -341,6 +341,8 @@ type WorkflowStatusConnector interface {
 
 	// Bulk returns a list of all statuses associated with active workflows.
 	//
+	// Map the statuses to their categories with models.NewStatusCategoryIndex.
+	//
 	// GET /rest/api/{2-3}/status
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#bulk-workflow-statuses
@@ -360,4 +362,29 @@ type WorkflowStatusConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/workflow/status#get-workflow-status
 	Get(ctx context.Context, idOrName string) (*model.StatusDetailScheme, *model.ResponseScheme, error)
+
+	// Categories returns a list of all status categories.
+	//
+	// GET /rest/api/{2-3}/statuscategory
+	Categories(ctx context.Context) ([]*model.StatusCategoryScheme, *model.ResponseScheme, error)
+
+	// Category returns a status category by ID or key.
+	//
+	// GET /rest/api/{2-3}/statuscategory/{idOrKey}
+	Category(ctx context.Context, idOrKey string) (*model.StatusCategoryScheme, *model.ResponseScheme, error)
+
+	// ProjectUsages returns a page of the projects using a status.
+	//
+	// GET /rest/api/{2-3}/statuses/{statusID}/projectUsages
+	ProjectUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusProjectUsageScheme, *model.ResponseScheme, error)
+
+	// IssueTypeUsages returns a page of the issue types of a project using a status.
+	//
+	// GET /rest/api/{2-3}/statuses/{statusID}/project/{projectID}/issueTypeUsages
+	IssueTypeUsages(ctx context.Context, statusID, projectID, nextPageToken string, maxResults int) (*model.StatusIssueTypeUsageScheme, *model.ResponseScheme, error)
+
+	// WorkflowUsages returns a page of the workflows using a status.
+	//
+	// GET /rest/api/{2-3}/statuses/{statusID}/workflowUsages
+	WorkflowUsages(ctx context.Context, statusID, nextPageToken string, maxResults int) (*model.StatusWorkflowUsageScheme, *model.ResponseScheme, error)
 }